@@ -84,6 +84,11 @@ func (x *ProviderDocumentGenerator) genTableDoc(table *schema.Table) []string {
 	// title
 	sb.AppendString("# Table: ").AppendString(table.TableName).AppendString("\n\n")
 
+	// tags
+	if len(table.Tags) != 0 {
+		sb.AppendString("Tags: ").AppendString(strings.Join(table.Tags, ", ")).AppendString("\n\n")
+	}
+
 	if table.Options != nil {
 
 		// pk
@@ -108,6 +113,11 @@ func (x *ProviderDocumentGenerator) genTableDoc(table *schema.Table) []string {
 		x.genColumns(sb, table, table.Columns)
 	}
 
+	// the parameters of the targeted refresh
+	if len(table.Parameters) != 0 {
+		x.genParameters(sb, table.Parameters)
+	}
+
 	tableFileName := x.genTableDocumentFileName(table.TableName)
 	_ = os.WriteFile(tableFileName, []byte(sb.String()), 0777)
 
@@ -190,6 +200,26 @@ func (x *ProviderDocumentGenerator) genColumns(sb *string_builder.StringBuilder,
 	sb.AppendString("\n\n")
 }
 
+func (x *ProviderDocumentGenerator) genParameters(sb *string_builder.StringBuilder, parameters []*schema.TableParameter) {
+
+	sb.AppendString("## Parameters \n\n")
+
+	sb.AppendString("|  Parameter Name   |  Type  | Required | Description | \n")
+	sb.AppendString("|  ----  | ----  | ----  | ---- | \n")
+
+	for _, parameter := range parameters {
+
+		required := "X"
+		if parameter.IsRequired {
+			required = "√"
+		}
+
+		sb.AppendString(fmt.Sprintf("| %s | %s | %s | %s | \n", parameter.Name, parameter.Type.String(), required, parameter.Description))
+	}
+
+	sb.AppendString("\n\n")
+}
+
 func (x *ProviderDocumentGenerator) genTableDocumentFileName(tableName string) string {
 	return x.outputDirectory + "/" + tableName + ".md"
 }
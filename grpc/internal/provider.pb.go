@@ -267,7 +267,7 @@ func (x Diagnostic_DiagnosticLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Diagnostic_DiagnosticLevel.Descriptor instead.
 func (Diagnostic_DiagnosticLevel) EnumDescriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{14, 0}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{25, 0}
 }
 
 type ProviderInit struct {
@@ -357,6 +357,7 @@ type Table struct {
 	Columns     []*Column `protobuf:"bytes,4,rep,name=columns,proto3" json:"columns,omitempty"`
 	SubTables   []*Table  `protobuf:"bytes,5,rep,name=sub_tables,json=subTables,proto3" json:"sub_tables,omitempty"`
 	Version     uint64    `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Tags        []string  `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *Table) Reset() {
@@ -433,6 +434,13 @@ func (x *Table) GetVersion() uint64 {
 	return 0
 }
 
+func (x *Table) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type Column struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -746,6 +754,44 @@ func (*CheckConfig) Descriptor() ([]byte, []int) {
 	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{8}
 }
 
+type GetCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCapabilities) Reset() {
+	*x = GetCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilities) ProtoMessage() {}
+
+func (x *GetCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilities.ProtoReflect.Descriptor instead.
+func (*GetCapabilities) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{9}
+}
+
 type SetProviderConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -755,7 +801,7 @@ type SetProviderConfig struct {
 func (x *SetProviderConfig) Reset() {
 	*x = SetProviderConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[9]
+		mi := &file_grpc_internal_provider_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -768,7 +814,7 @@ func (x *SetProviderConfig) String() string {
 func (*SetProviderConfig) ProtoMessage() {}
 
 func (x *SetProviderConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[9]
+	mi := &file_grpc_internal_provider_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -781,7 +827,7 @@ func (x *SetProviderConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetProviderConfig.ProtoReflect.Descriptor instead.
 func (*SetProviderConfig) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{9}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{10}
 }
 
 type Storage struct {
@@ -796,7 +842,7 @@ type Storage struct {
 func (x *Storage) Reset() {
 	*x = Storage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[10]
+		mi := &file_grpc_internal_provider_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -809,7 +855,7 @@ func (x *Storage) String() string {
 func (*Storage) ProtoMessage() {}
 
 func (x *Storage) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[10]
+	mi := &file_grpc_internal_provider_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -822,7 +868,7 @@ func (x *Storage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Storage.ProtoReflect.Descriptor instead.
 func (*Storage) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{10}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Storage) GetType() StorageType {
@@ -848,7 +894,7 @@ type PullTables struct {
 func (x *PullTables) Reset() {
 	*x = PullTables{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[11]
+		mi := &file_grpc_internal_provider_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +907,7 @@ func (x *PullTables) String() string {
 func (*PullTables) ProtoMessage() {}
 
 func (x *PullTables) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[11]
+	mi := &file_grpc_internal_provider_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,32 +920,35 @@ func (x *PullTables) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullTables.ProtoReflect.Descriptor instead.
 func (*PullTables) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{11}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{12}
 }
 
-type DropTableAll struct {
+// The values of the parameters of a table, <parameterName, value>
+type TableParameters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Values map[string]*QueryValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DropTableAll) Reset() {
-	*x = DropTableAll{}
+func (x *TableParameters) Reset() {
+	*x = TableParameters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[12]
+		mi := &file_grpc_internal_provider_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DropTableAll) String() string {
+func (x *TableParameters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DropTableAll) ProtoMessage() {}
+func (*TableParameters) ProtoMessage() {}
 
-func (x *DropTableAll) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[12]
+func (x *TableParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,34 +959,41 @@ func (x *DropTableAll) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DropTableAll.ProtoReflect.Descriptor instead.
-func (*DropTableAll) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TableParameters.ProtoReflect.Descriptor instead.
+func (*TableParameters) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{13}
 }
 
-type CreateAllTables struct {
+func (x *TableParameters) GetValues() map[string]*QueryValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type CancelPull struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *CreateAllTables) Reset() {
-	*x = CreateAllTables{}
+func (x *CancelPull) Reset() {
+	*x = CancelPull{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[13]
+		mi := &file_grpc_internal_provider_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateAllTables) String() string {
+func (x *CancelPull) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAllTables) ProtoMessage() {}
+func (*CancelPull) ProtoMessage() {}
 
-func (x *CreateAllTables) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[13]
+func (x *CancelPull) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -948,37 +1004,34 @@ func (x *CreateAllTables) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAllTables.ProtoReflect.Descriptor instead.
-func (*CreateAllTables) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use CancelPull.ProtoReflect.Descriptor instead.
+func (*CancelPull) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{14}
 }
 
-type Diagnostic struct {
+type CancelTable struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	DiagnosticLevel Diagnostic_DiagnosticLevel `protobuf:"varint,1,opt,name=diagnosticLevel,proto3,enum=proto.Diagnostic_DiagnosticLevel" json:"diagnosticLevel,omitempty"`
-	Content         string                     `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *Diagnostic) Reset() {
-	*x = Diagnostic{}
+func (x *CancelTable) Reset() {
+	*x = CancelTable{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[14]
+		mi := &file_grpc_internal_provider_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Diagnostic) String() string {
+func (x *CancelTable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Diagnostic) ProtoMessage() {}
+func (*CancelTable) ProtoMessage() {}
 
-func (x *Diagnostic) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[14]
+func (x *CancelTable) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,53 +1042,86 @@ func (x *Diagnostic) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
-func (*Diagnostic) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CancelTable.ProtoReflect.Descriptor instead.
+func (*CancelTable) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Diagnostic) GetDiagnosticLevel() Diagnostic_DiagnosticLevel {
-	if x != nil {
-		return x.DiagnosticLevel
+type GetPullStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPullStatus) Reset() {
+	*x = GetPullStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return Diagnostic_DiagnosisLevelTrace
 }
 
-func (x *Diagnostic) GetContent() string {
-	if x != nil {
-		return x.Content
+func (x *GetPullStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullStatus) ProtoMessage() {}
+
+func (x *GetPullStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ProviderInit_Request struct {
+// Deprecated: Use GetPullStatus.ProtoReflect.Descriptor instead.
+func (*GetPullStatus) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{16}
+}
+
+type PullCounters struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Storage        *Storage `protobuf:"bytes,1,opt,name=storage,proto3,oneof" json:"storage,omitempty"`
-	Workspace      *string  `protobuf:"bytes,2,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"`
-	ProviderConfig *string  `protobuf:"bytes,3,opt,name=provider_config,json=providerConfig,proto3,oneof" json:"provider_config,omitempty"`
-	IsInstallInit  *bool    `protobuf:"varint,4,opt,name=is_install_init,json=isInstallInit,proto3,oneof" json:"is_install_init,omitempty"`
-}
-
-func (x *ProviderInit_Request) Reset() {
-	*x = ProviderInit_Request{}
+	TaskCount          int64 `protobuf:"varint,1,opt,name=task_count,json=taskCount,proto3" json:"task_count,omitempty"`
+	RawResultCount     int64 `protobuf:"varint,2,opt,name=raw_result_count,json=rawResultCount,proto3" json:"raw_result_count,omitempty"`
+	DroppedResultCount int64 `protobuf:"varint,3,opt,name=dropped_result_count,json=droppedResultCount,proto3" json:"dropped_result_count,omitempty"`
+	RowCount           int64 `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	ErrorCount         int64 `protobuf:"varint,5,opt,name=error_count,json=errorCount,proto3" json:"error_count,omitempty"`
+	IgnoredErrorCount  int64 `protobuf:"varint,6,opt,name=ignored_error_count,json=ignoredErrorCount,proto3" json:"ignored_error_count,omitempty"`
+	RetryCount         int64 `protobuf:"varint,7,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	// in milliseconds
+	Duration         int64 `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	SkippedTaskCount int64 `protobuf:"varint,9,opt,name=skipped_task_count,json=skippedTaskCount,proto3" json:"skipped_task_count,omitempty"`
+	// in milliseconds
+	ResultChannelBlockedTime int64 `protobuf:"varint,10,opt,name=result_channel_blocked_time,json=resultChannelBlockedTime,proto3" json:"result_channel_blocked_time,omitempty"`
+	DuplicateRowCount        int64 `protobuf:"varint,11,opt,name=duplicate_row_count,json=duplicateRowCount,proto3" json:"duplicate_row_count,omitempty"`
+}
+
+func (x *PullCounters) Reset() {
+	*x = PullCounters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[15]
+		mi := &file_grpc_internal_provider_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderInit_Request) String() string {
+func (x *PullCounters) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderInit_Request) ProtoMessage() {}
+func (*PullCounters) ProtoMessage() {}
 
-func (x *ProviderInit_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[15]
+func (x *PullCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1046,64 +1132,116 @@ func (x *ProviderInit_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderInit_Request.ProtoReflect.Descriptor instead.
-func (*ProviderInit_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use PullCounters.ProtoReflect.Descriptor instead.
+func (*PullCounters) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ProviderInit_Request) GetStorage() *Storage {
+func (x *PullCounters) GetTaskCount() int64 {
 	if x != nil {
-		return x.Storage
+		return x.TaskCount
 	}
-	return nil
+	return 0
 }
 
-func (x *ProviderInit_Request) GetWorkspace() string {
-	if x != nil && x.Workspace != nil {
-		return *x.Workspace
+func (x *PullCounters) GetRawResultCount() int64 {
+	if x != nil {
+		return x.RawResultCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ProviderInit_Request) GetProviderConfig() string {
-	if x != nil && x.ProviderConfig != nil {
-		return *x.ProviderConfig
+func (x *PullCounters) GetDroppedResultCount() int64 {
+	if x != nil {
+		return x.DroppedResultCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ProviderInit_Request) GetIsInstallInit() bool {
-	if x != nil && x.IsInstallInit != nil {
-		return *x.IsInstallInit
+func (x *PullCounters) GetRowCount() int64 {
+	if x != nil {
+		return x.RowCount
 	}
-	return false
+	return 0
 }
 
-type ProviderInit_Response struct {
+func (x *PullCounters) GetErrorCount() int64 {
+	if x != nil {
+		return x.ErrorCount
+	}
+	return 0
+}
+
+func (x *PullCounters) GetIgnoredErrorCount() int64 {
+	if x != nil {
+		return x.IgnoredErrorCount
+	}
+	return 0
+}
+
+func (x *PullCounters) GetRetryCount() int64 {
+	if x != nil {
+		return x.RetryCount
+	}
+	return 0
+}
+
+func (x *PullCounters) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PullCounters) GetSkippedTaskCount() int64 {
+	if x != nil {
+		return x.SkippedTaskCount
+	}
+	return 0
+}
+
+func (x *PullCounters) GetResultChannelBlockedTime() int64 {
+	if x != nil {
+		return x.ResultChannelBlockedTime
+	}
+	return 0
+}
+
+func (x *PullCounters) GetDuplicateRowCount() int64 {
+	if x != nil {
+		return x.DuplicateRowCount
+	}
+	return 0
+}
+
+type PullClientStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Counters      *PullCounters     `protobuf:"bytes,1,opt,name=counters,proto3" json:"counters,omitempty"`
+	ClientKey     string            `protobuf:"bytes,2,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	ClientLabels  map[string]string `protobuf:"bytes,3,rep,name=client_labels,json=clientLabels,proto3" json:"client_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IsCircuitOpen bool              `protobuf:"varint,4,opt,name=is_circuit_open,json=isCircuitOpen,proto3" json:"is_circuit_open,omitempty"`
 }
 
-func (x *ProviderInit_Response) Reset() {
-	*x = ProviderInit_Response{}
+func (x *PullClientStatistics) Reset() {
+	*x = PullClientStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[16]
+		mi := &file_grpc_internal_provider_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProviderInit_Response) String() string {
+func (x *PullClientStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProviderInit_Response) ProtoMessage() {}
+func (*PullClientStatistics) ProtoMessage() {}
 
-func (x *ProviderInit_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[16]
+func (x *PullClientStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,41 +1252,69 @@ func (x *ProviderInit_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProviderInit_Response.ProtoReflect.Descriptor instead.
-func (*ProviderInit_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use PullClientStatistics.ProtoReflect.Descriptor instead.
+func (*PullClientStatistics) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ProviderInit_Response) GetDiagnostics() []*Diagnostic {
+func (x *PullClientStatistics) GetCounters() *PullCounters {
 	if x != nil {
-		return x.Diagnostics
+		return x.Counters
 	}
 	return nil
 }
 
-type GetProviderInformation_Request struct {
+func (x *PullClientStatistics) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *PullClientStatistics) GetClientLabels() map[string]string {
+	if x != nil {
+		return x.ClientLabels
+	}
+	return nil
+}
+
+func (x *PullClientStatistics) GetIsCircuitOpen() bool {
+	if x != nil {
+		return x.IsCircuitOpen
+	}
+	return false
+}
+
+type PullTableStatistics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Counters  *PullCounters `protobuf:"bytes,1,opt,name=counters,proto3" json:"counters,omitempty"`
+	TableName string        `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// in milliseconds
+	ThrottleWaitTime int64                            `protobuf:"varint,3,opt,name=throttle_wait_time,json=throttleWaitTime,proto3" json:"throttle_wait_time,omitempty"`
+	IsCutOff         bool                             `protobuf:"varint,4,opt,name=is_cut_off,json=isCutOff,proto3" json:"is_cut_off,omitempty"`
+	ClientStatistics map[string]*PullClientStatistics `protobuf:"bytes,5,rep,name=client_statistics,json=clientStatistics,proto3" json:"client_statistics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *GetProviderInformation_Request) Reset() {
-	*x = GetProviderInformation_Request{}
+func (x *PullTableStatistics) Reset() {
+	*x = PullTableStatistics{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[17]
+		mi := &file_grpc_internal_provider_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProviderInformation_Request) String() string {
+func (x *PullTableStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderInformation_Request) ProtoMessage() {}
+func (*PullTableStatistics) ProtoMessage() {}
 
-func (x *GetProviderInformation_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[17]
+func (x *PullTableStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,40 +1325,1030 @@ func (x *GetProviderInformation_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderInformation_Request.ProtoReflect.Descriptor instead.
-func (*GetProviderInformation_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use PullTableStatistics.ProtoReflect.Descriptor instead.
+func (*PullTableStatistics) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{19}
 }
 
-type GetProviderInformation_Response struct {
-	state         protoimpl.MessageState
+func (x *PullTableStatistics) GetCounters() *PullCounters {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *PullTableStatistics) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *PullTableStatistics) GetThrottleWaitTime() int64 {
+	if x != nil {
+		return x.ThrottleWaitTime
+	}
+	return 0
+}
+
+func (x *PullTableStatistics) GetIsCutOff() bool {
+	if x != nil {
+		return x.IsCutOff
+	}
+	return false
+}
+
+func (x *PullTableStatistics) GetClientStatistics() map[string]*PullClientStatistics {
+	if x != nil {
+		return x.ClientStatistics
+	}
+	return nil
+}
+
+type DropTableAll struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DropTableAll) Reset() {
+	*x = DropTableAll{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DropTableAll) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropTableAll) ProtoMessage() {}
+
+func (x *DropTableAll) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropTableAll.ProtoReflect.Descriptor instead.
+func (*DropTableAll) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{20}
+}
+
+type CreateAllTables struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateAllTables) Reset() {
+	*x = CreateAllTables{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAllTables) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllTables) ProtoMessage() {}
+
+func (x *CreateAllTables) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllTables.ProtoReflect.Descriptor instead.
+func (*CreateAllTables) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{21}
+}
+
+// Run a read-only query on the provider's storage
+type Query struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Query) Reset() {
+	*x = Query{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Query) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query) ProtoMessage() {}
+
+func (x *Query) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{22}
+}
+
+type QueryRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Values []*QueryValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *QueryRow) Reset() {
+	*x = QueryRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRow) ProtoMessage() {}
+
+func (x *QueryRow) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRow.ProtoReflect.Descriptor instead.
+func (*QueryRow) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryRow) GetValues() []*QueryValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type QueryValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Value:
+	//	*QueryValue_IsNull
+	//	*QueryValue_BoolValue
+	//	*QueryValue_IntValue
+	//	*QueryValue_FloatValue
+	//	*QueryValue_StringValue
+	//	*QueryValue_BytesValue
+	//	*QueryValue_TimestampValue
+	//	*QueryValue_JsonValue
+	Value isQueryValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *QueryValue) Reset() {
+	*x = QueryValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryValue) ProtoMessage() {}
+
+func (x *QueryValue) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryValue.ProtoReflect.Descriptor instead.
+func (*QueryValue) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{24}
+}
+
+func (m *QueryValue) GetValue() isQueryValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *QueryValue) GetIsNull() bool {
+	if x, ok := x.GetValue().(*QueryValue_IsNull); ok {
+		return x.IsNull
+	}
+	return false
+}
+
+func (x *QueryValue) GetBoolValue() bool {
+	if x, ok := x.GetValue().(*QueryValue_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *QueryValue) GetIntValue() int64 {
+	if x, ok := x.GetValue().(*QueryValue_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *QueryValue) GetFloatValue() float64 {
+	if x, ok := x.GetValue().(*QueryValue_FloatValue); ok {
+		return x.FloatValue
+	}
+	return 0
+}
+
+func (x *QueryValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*QueryValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *QueryValue) GetBytesValue() []byte {
+	if x, ok := x.GetValue().(*QueryValue_BytesValue); ok {
+		return x.BytesValue
+	}
+	return nil
+}
+
+func (x *QueryValue) GetTimestampValue() int64 {
+	if x, ok := x.GetValue().(*QueryValue_TimestampValue); ok {
+		return x.TimestampValue
+	}
+	return 0
+}
+
+func (x *QueryValue) GetJsonValue() string {
+	if x, ok := x.GetValue().(*QueryValue_JsonValue); ok {
+		return x.JsonValue
+	}
+	return ""
+}
+
+type isQueryValue_Value interface {
+	isQueryValue_Value()
+}
+
+type QueryValue_IsNull struct {
+	IsNull bool `protobuf:"varint,1,opt,name=is_null,json=isNull,proto3,oneof"`
+}
+
+type QueryValue_BoolValue struct {
+	BoolValue bool `protobuf:"varint,2,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type QueryValue_IntValue struct {
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type QueryValue_FloatValue struct {
+	FloatValue float64 `protobuf:"fixed64,4,opt,name=float_value,json=floatValue,proto3,oneof"`
+}
+
+type QueryValue_StringValue struct {
+	StringValue string `protobuf:"bytes,5,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type QueryValue_BytesValue struct {
+	BytesValue []byte `protobuf:"bytes,6,opt,name=bytes_value,json=bytesValue,proto3,oneof"`
+}
+
+type QueryValue_TimestampValue struct {
+	// unix nano
+	TimestampValue int64 `protobuf:"varint,7,opt,name=timestamp_value,json=timestampValue,proto3,oneof"`
+}
+
+type QueryValue_JsonValue struct {
+	// array, map and other complex value is encoded as json
+	JsonValue string `protobuf:"bytes,8,opt,name=json_value,json=jsonValue,proto3,oneof"`
+}
+
+func (*QueryValue_IsNull) isQueryValue_Value() {}
+
+func (*QueryValue_BoolValue) isQueryValue_Value() {}
+
+func (*QueryValue_IntValue) isQueryValue_Value() {}
+
+func (*QueryValue_FloatValue) isQueryValue_Value() {}
+
+func (*QueryValue_StringValue) isQueryValue_Value() {}
+
+func (*QueryValue_BytesValue) isQueryValue_Value() {}
+
+func (*QueryValue_TimestampValue) isQueryValue_Value() {}
+
+func (*QueryValue_JsonValue) isQueryValue_Value() {}
+
+type Diagnostic struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiagnosticLevel Diagnostic_DiagnosticLevel `protobuf:"varint,1,opt,name=diagnosticLevel,proto3,enum=proto.Diagnostic_DiagnosticLevel" json:"diagnosticLevel,omitempty"`
+	Content         string                     `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// Which client the diagnostic is about, empty if it is not about a client
+	ClientKey    string            `protobuf:"bytes,3,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	ClientLabels map[string]string `protobuf:"bytes,4,rep,name=client_labels,json=clientLabels,proto3" json:"client_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Diagnostic) GetDiagnosticLevel() Diagnostic_DiagnosticLevel {
+	if x != nil {
+		return x.DiagnosticLevel
+	}
+	return Diagnostic_DiagnosisLevelTrace
+}
+
+func (x *Diagnostic) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetClientKey() string {
+	if x != nil {
+		return x.ClientKey
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetClientLabels() map[string]string {
+	if x != nil {
+		return x.ClientLabels
+	}
+	return nil
+}
+
+type ProviderInit_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Storage        *Storage `protobuf:"bytes,1,opt,name=storage,proto3,oneof" json:"storage,omitempty"`
+	Workspace      *string  `protobuf:"bytes,2,opt,name=workspace,proto3,oneof" json:"workspace,omitempty"`
+	ProviderConfig *string  `protobuf:"bytes,3,opt,name=provider_config,json=providerConfig,proto3,oneof" json:"provider_config,omitempty"`
+	IsInstallInit  *bool    `protobuf:"varint,4,opt,name=is_install_init,json=isInstallInit,proto3,oneof" json:"is_install_init,omitempty"`
+}
+
+func (x *ProviderInit_Request) Reset() {
+	*x = ProviderInit_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderInit_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderInit_Request) ProtoMessage() {}
+
+func (x *ProviderInit_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderInit_Request.ProtoReflect.Descriptor instead.
+func (*ProviderInit_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ProviderInit_Request) GetStorage() *Storage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+func (x *ProviderInit_Request) GetWorkspace() string {
+	if x != nil && x.Workspace != nil {
+		return *x.Workspace
+	}
+	return ""
+}
+
+func (x *ProviderInit_Request) GetProviderConfig() string {
+	if x != nil && x.ProviderConfig != nil {
+		return *x.ProviderConfig
+	}
+	return ""
+}
+
+func (x *ProviderInit_Request) GetIsInstallInit() bool {
+	if x != nil && x.IsInstallInit != nil {
+		return *x.IsInstallInit
+	}
+	return false
+}
+
+type ProviderInit_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *ProviderInit_Response) Reset() {
+	*x = ProviderInit_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProviderInit_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderInit_Response) ProtoMessage() {}
+
+func (x *ProviderInit_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderInit_Response.ProtoReflect.Descriptor instead.
+func (*ProviderInit_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *ProviderInit_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type GetProviderInformation_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProviderInformation_Request) Reset() {
+	*x = GetProviderInformation_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProviderInformation_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderInformation_Request) ProtoMessage() {}
+
+func (x *GetProviderInformation_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderInformation_Request.ProtoReflect.Descriptor instead.
+func (*GetProviderInformation_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type GetProviderInformation_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                  string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version               string            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Tables                map[string]*Table `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DefaultConfigTemplate string            `protobuf:"bytes,4,opt,name=default_config_template,json=defaultConfigTemplate,proto3" json:"default_config_template,omitempty"`
+	Diagnostics           []*Diagnostic     `protobuf:"bytes,5,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *GetProviderInformation_Response) Reset() {
+	*x = GetProviderInformation_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProviderInformation_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderInformation_Response) ProtoMessage() {}
+
+func (x *GetProviderInformation_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderInformation_Response.ProtoReflect.Descriptor instead.
+func (*GetProviderInformation_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetProviderInformation_Response) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProviderInformation_Response) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetProviderInformation_Response) GetTables() map[string]*Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *GetProviderInformation_Response) GetDefaultConfigTemplate() string {
+	if x != nil {
+		return x.DefaultConfigTemplate
+	}
+	return ""
+}
+
+func (x *GetProviderInformation_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type GetProviderConfig_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetProviderConfig_Request) Reset() {
+	*x = GetProviderConfig_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProviderConfig_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderConfig_Request) ProtoMessage() {}
+
+func (x *GetProviderConfig_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderConfig_Request.ProtoReflect.Descriptor instead.
+func (*GetProviderConfig_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type GetProviderConfig_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version     string        `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Config      string        `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *GetProviderConfig_Response) Reset() {
+	*x = GetProviderConfig_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProviderConfig_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderConfig_Response) ProtoMessage() {}
+
+func (x *GetProviderConfig_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderConfig_Response.ProtoReflect.Descriptor instead.
+func (*GetProviderConfig_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *GetProviderConfig_Response) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProviderConfig_Response) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetProviderConfig_Response) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *GetProviderConfig_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type CheckConfig_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// Also check the credentials in the config can access the API
+	ProbeCredentials bool `protobuf:"varint,2,opt,name=probe_credentials,json=probeCredentials,proto3" json:"probe_credentials,omitempty"`
+}
+
+func (x *CheckConfig_Request) Reset() {
+	*x = CheckConfig_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConfig_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConfig_Request) ProtoMessage() {}
+
+func (x *CheckConfig_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConfig_Request.ProtoReflect.Descriptor instead.
+func (*CheckConfig_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *CheckConfig_Request) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+func (x *CheckConfig_Request) GetProbeCredentials() bool {
+	if x != nil {
+		return x.ProbeCredentials
+	}
+	return false
+}
+
+type CheckConfig_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *CheckConfig_Response) Reset() {
+	*x = CheckConfig_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckConfig_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConfig_Response) ProtoMessage() {}
+
+func (x *CheckConfig_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConfig_Response.ProtoReflect.Descriptor instead.
+func (*CheckConfig_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *CheckConfig_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type GetCapabilities_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCapabilities_Request) Reset() {
+	*x = GetCapabilities_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCapabilities_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilities_Request) ProtoMessage() {}
+
+func (x *GetCapabilities_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilities_Request.ProtoReflect.Descriptor instead.
+func (*GetCapabilities_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type GetCapabilities_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The latest protocol version the provider speaks
+	ProtocolVersion int64 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// The optional features the provider supports, such as check_config, cancel_pull, incremental
+	Features     []string      `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+	StorageTypes []StorageType `protobuf:"varint,3,rep,packed,name=storage_types,json=storageTypes,proto3,enum=proto.StorageType" json:"storage_types,omitempty"`
+	Diagnostics  []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *GetCapabilities_Response) Reset() {
+	*x = GetCapabilities_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCapabilities_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilities_Response) ProtoMessage() {}
+
+func (x *GetCapabilities_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilities_Response.ProtoReflect.Descriptor instead.
+func (*GetCapabilities_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *GetCapabilities_Response) GetProtocolVersion() int64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *GetCapabilities_Response) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *GetCapabilities_Response) GetStorageTypes() []StorageType {
+	if x != nil {
+		return x.StorageTypes
+	}
+	return nil
+}
+
+func (x *GetCapabilities_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type SetProviderConfig_Request struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name                  string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version               string            `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Tables                map[string]*Table `protobuf:"bytes,3,rep,name=tables,proto3" json:"tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	DefaultConfigTemplate string            `protobuf:"bytes,4,opt,name=default_config_template,json=defaultConfigTemplate,proto3" json:"default_config_template,omitempty"`
-	Diagnostics           []*Diagnostic     `protobuf:"bytes,5,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Storage        *Storage `protobuf:"bytes,1,opt,name=storage,proto3,oneof" json:"storage,omitempty"`
+	ProviderConfig *string  `protobuf:"bytes,2,opt,name=provider_config,json=providerConfig,proto3,oneof" json:"provider_config,omitempty"`
 }
 
-func (x *GetProviderInformation_Response) Reset() {
-	*x = GetProviderInformation_Response{}
+func (x *SetProviderConfig_Request) Reset() {
+	*x = SetProviderConfig_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[18]
+		mi := &file_grpc_internal_provider_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProviderInformation_Response) String() string {
+func (x *SetProviderConfig_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderInformation_Response) ProtoMessage() {}
+func (*SetProviderConfig_Request) ProtoMessage() {}
 
-func (x *GetProviderInformation_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[18]
+func (x *SetProviderConfig_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,69 +2359,121 @@ func (x *GetProviderInformation_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderInformation_Response.ProtoReflect.Descriptor instead.
-func (*GetProviderInformation_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use SetProviderConfig_Request.ProtoReflect.Descriptor instead.
+func (*SetProviderConfig_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{10, 0}
 }
 
-func (x *GetProviderInformation_Response) GetName() string {
+func (x *SetProviderConfig_Request) GetStorage() *Storage {
 	if x != nil {
-		return x.Name
+		return x.Storage
 	}
-	return ""
+	return nil
 }
 
-func (x *GetProviderInformation_Response) GetVersion() string {
-	if x != nil {
-		return x.Version
+func (x *SetProviderConfig_Request) GetProviderConfig() string {
+	if x != nil && x.ProviderConfig != nil {
+		return *x.ProviderConfig
 	}
 	return ""
 }
 
-func (x *GetProviderInformation_Response) GetTables() map[string]*Table {
-	if x != nil {
-		return x.Tables
+type SetProviderConfig_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *SetProviderConfig_Response) Reset() {
+	*x = SetProviderConfig_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *GetProviderInformation_Response) GetDefaultConfigTemplate() string {
-	if x != nil {
-		return x.DefaultConfigTemplate
+func (x *SetProviderConfig_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProviderConfig_Response) ProtoMessage() {}
+
+func (x *SetProviderConfig_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetProviderInformation_Response) GetDiagnostics() []*Diagnostic {
+// Deprecated: Use SetProviderConfig_Response.ProtoReflect.Descriptor instead.
+func (*SetProviderConfig_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{10, 1}
+}
+
+func (x *SetProviderConfig_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-type GetProviderConfig_Request struct {
+type PullTables_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Tables        []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
+	MaxGoroutines uint64   `protobuf:"varint,2,opt,name=max_goroutines,json=maxGoroutines,proto3" json:"max_goroutines,omitempty"`
+	Timeout       int64    `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// The memory limit of the pull, in MB, 0 means use the provider's setting
+	MaxMemoryMb uint64 `protobuf:"varint,4,opt,name=max_memory_mb,json=maxMemoryMb,proto3" json:"max_memory_mb,omitempty"`
+	// Resume from the checkpoint of the last interrupted pull of the same tables, skip the completed work
+	Resume bool `protobuf:"varint,5,opt,name=resume,proto3" json:"resume,omitempty"`
+	// Ignore the watermarks of the incremental tables, pull them in full
+	FullResync bool `protobuf:"varint,6,opt,name=full_resync,json=fullResync,proto3" json:"full_resync,omitempty"`
+	// Run the extraction and transformation only, the rows are sent to the dry-run sink instead of the storage
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Sampling mode for the smoke runs, at most how many raw results of each table are pulled, 0 means no limit
+	SampleResultLimit uint64 `protobuf:"varint,8,opt,name=sample_result_limit,json=sampleResultLimit,proto3" json:"sample_result_limit,omitempty"`
+	// Sampling mode for the smoke runs, at most how many child tasks of each sub table are started by a parent task, 0 means no limit
+	SampleChildTaskLimit uint64 `protobuf:"varint,9,opt,name=sample_child_task_limit,json=sampleChildTaskLimit,proto3" json:"sample_child_task_limit,omitempty"`
+	// <tableName, parameters>, the parameters passed to the Pull of the tables for a targeted refresh, checked by the parameters declared on the tables
+	TableParameters map[string]*TableParameters `protobuf:"bytes,10,rep,name=table_parameters,json=tableParameters,proto3" json:"table_parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The tables matching these patterns are not pulled, and neither are their sub tables, the same patterns as tables
+	ExcludeTables []string `protobuf:"bytes,11,rep,name=exclude_tables,json=excludeTables,proto3" json:"exclude_tables,omitempty"`
+	// Also pull the tables with any of these tags
+	Tags []string `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The tables with any of these tags are not pulled, and neither are their sub tables
+	ExcludeTags []string `protobuf:"bytes,13,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	// Save the progress of the pull, so that it can be resumed if interrupted, it is always saved when resume is set
+	Checkpoint bool `protobuf:"varint,14,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
 }
 
-func (x *GetProviderConfig_Request) Reset() {
-	*x = GetProviderConfig_Request{}
+func (x *PullTables_Request) Reset() {
+	*x = PullTables_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[20]
+		mi := &file_grpc_internal_provider_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProviderConfig_Request) String() string {
+func (x *PullTables_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderConfig_Request) ProtoMessage() {}
+func (*PullTables_Request) ProtoMessage() {}
 
-func (x *GetProviderConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[20]
+func (x *PullTables_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1276,39 +2484,143 @@ func (x *GetProviderConfig_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderConfig_Request.ProtoReflect.Descriptor instead.
-func (*GetProviderConfig_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use PullTables_Request.ProtoReflect.Descriptor instead.
+func (*PullTables_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{12, 0}
 }
 
-type GetProviderConfig_Response struct {
+func (x *PullTables_Request) GetTables() []string {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+func (x *PullTables_Request) GetMaxGoroutines() uint64 {
+	if x != nil {
+		return x.MaxGoroutines
+	}
+	return 0
+}
+
+func (x *PullTables_Request) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *PullTables_Request) GetMaxMemoryMb() uint64 {
+	if x != nil {
+		return x.MaxMemoryMb
+	}
+	return 0
+}
+
+func (x *PullTables_Request) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+func (x *PullTables_Request) GetFullResync() bool {
+	if x != nil {
+		return x.FullResync
+	}
+	return false
+}
+
+func (x *PullTables_Request) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *PullTables_Request) GetSampleResultLimit() uint64 {
+	if x != nil {
+		return x.SampleResultLimit
+	}
+	return 0
+}
+
+func (x *PullTables_Request) GetSampleChildTaskLimit() uint64 {
+	if x != nil {
+		return x.SampleChildTaskLimit
+	}
+	return 0
+}
+
+func (x *PullTables_Request) GetTableParameters() map[string]*TableParameters {
+	if x != nil {
+		return x.TableParameters
+	}
+	return nil
+}
+
+func (x *PullTables_Request) GetExcludeTables() []string {
+	if x != nil {
+		return x.ExcludeTables
+	}
+	return nil
+}
+
+func (x *PullTables_Request) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PullTables_Request) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
+func (x *PullTables_Request) GetCheckpoint() bool {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return false
+}
+
+type PullTables_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Version     string        `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
-	Config      string        `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	// map of tables that have finished fetching
+	FinishedTables map[string]bool `protobuf:"bytes,1,rep,name=finished_tables,json=finishedTables,proto3" json:"finished_tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Amount of tables collected so far
+	TableCount  uint64        `protobuf:"varint,2,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	Table       string        `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
 	Diagnostics []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	// The statistics of the tables, the progress message carries the finished table, the last message carries all tables
+	TableStatistics map[string]*PullTableStatistics `protobuf:"bytes,5,rep,name=table_statistics,json=tableStatistics,proto3" json:"table_statistics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The id of this pull, used to check or cancel the running pull
+	PullId string `protobuf:"bytes,6,opt,name=pull_id,json=pullId,proto3" json:"pull_id,omitempty"`
 }
 
-func (x *GetProviderConfig_Response) Reset() {
-	*x = GetProviderConfig_Response{}
+func (x *PullTables_Response) Reset() {
+	*x = PullTables_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[21]
+		mi := &file_grpc_internal_provider_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetProviderConfig_Response) String() string {
+func (x *PullTables_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProviderConfig_Response) ProtoMessage() {}
+func (*PullTables_Response) ProtoMessage() {}
 
-func (x *GetProviderConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[21]
+func (x *PullTables_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,64 +2631,78 @@ func (x *GetProviderConfig_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProviderConfig_Response.ProtoReflect.Descriptor instead.
-func (*GetProviderConfig_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use PullTables_Response.ProtoReflect.Descriptor instead.
+func (*PullTables_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{12, 1}
 }
 
-func (x *GetProviderConfig_Response) GetName() string {
+func (x *PullTables_Response) GetFinishedTables() map[string]bool {
 	if x != nil {
-		return x.Name
+		return x.FinishedTables
 	}
-	return ""
+	return nil
 }
 
-func (x *GetProviderConfig_Response) GetVersion() string {
+func (x *PullTables_Response) GetTableCount() uint64 {
 	if x != nil {
-		return x.Version
+		return x.TableCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetProviderConfig_Response) GetConfig() string {
+func (x *PullTables_Response) GetTable() string {
 	if x != nil {
-		return x.Config
+		return x.Table
 	}
 	return ""
 }
 
-func (x *GetProviderConfig_Response) GetDiagnostics() []*Diagnostic {
+func (x *PullTables_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-type CheckConfig_Request struct {
+func (x *PullTables_Response) GetTableStatistics() map[string]*PullTableStatistics {
+	if x != nil {
+		return x.TableStatistics
+	}
+	return nil
+}
+
+func (x *PullTables_Response) GetPullId() string {
+	if x != nil {
+		return x.PullId
+	}
+	return ""
+}
+
+type CancelPull_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	PullId string `protobuf:"bytes,1,opt,name=pull_id,json=pullId,proto3" json:"pull_id,omitempty"`
 }
 
-func (x *CheckConfig_Request) Reset() {
-	*x = CheckConfig_Request{}
+func (x *CancelPull_Request) Reset() {
+	*x = CancelPull_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[22]
+		mi := &file_grpc_internal_provider_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckConfig_Request) String() string {
+func (x *CancelPull_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckConfig_Request) ProtoMessage() {}
+func (*CancelPull_Request) ProtoMessage() {}
 
-func (x *CheckConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[22]
+func (x *CancelPull_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1387,19 +2713,19 @@ func (x *CheckConfig_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckConfig_Request.ProtoReflect.Descriptor instead.
-func (*CheckConfig_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{8, 0}
+// Deprecated: Use CancelPull_Request.ProtoReflect.Descriptor instead.
+func (*CancelPull_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{14, 0}
 }
 
-func (x *CheckConfig_Request) GetConfig() string {
+func (x *CancelPull_Request) GetPullId() string {
 	if x != nil {
-		return x.Config
+		return x.PullId
 	}
 	return ""
 }
 
-type CheckConfig_Response struct {
+type CancelPull_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1407,23 +2733,23 @@ type CheckConfig_Response struct {
 	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 }
 
-func (x *CheckConfig_Response) Reset() {
-	*x = CheckConfig_Response{}
+func (x *CancelPull_Response) Reset() {
+	*x = CancelPull_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[23]
+		mi := &file_grpc_internal_provider_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckConfig_Response) String() string {
+func (x *CancelPull_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckConfig_Response) ProtoMessage() {}
+func (*CancelPull_Response) ProtoMessage() {}
 
-func (x *CheckConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[23]
+func (x *CancelPull_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,44 +2760,45 @@ func (x *CheckConfig_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckConfig_Response.ProtoReflect.Descriptor instead.
-func (*CheckConfig_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{8, 1}
+// Deprecated: Use CancelPull_Response.ProtoReflect.Descriptor instead.
+func (*CancelPull_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{14, 1}
 }
 
-func (x *CheckConfig_Response) GetDiagnostics() []*Diagnostic {
+func (x *CancelPull_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-type SetProviderConfig_Request struct {
+type CancelTable_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Storage        *Storage `protobuf:"bytes,1,opt,name=storage,proto3,oneof" json:"storage,omitempty"`
-	ProviderConfig *string  `protobuf:"bytes,2,opt,name=provider_config,json=providerConfig,proto3,oneof" json:"provider_config,omitempty"`
+	PullId string `protobuf:"bytes,1,opt,name=pull_id,json=pullId,proto3" json:"pull_id,omitempty"`
+	// Only the root table can be cancelled, its sub tables are cancelled with it
+	Table string `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
 }
 
-func (x *SetProviderConfig_Request) Reset() {
-	*x = SetProviderConfig_Request{}
+func (x *CancelTable_Request) Reset() {
+	*x = CancelTable_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[24]
+		mi := &file_grpc_internal_provider_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetProviderConfig_Request) String() string {
+func (x *CancelTable_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetProviderConfig_Request) ProtoMessage() {}
+func (*CancelTable_Request) ProtoMessage() {}
 
-func (x *SetProviderConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[24]
+func (x *CancelTable_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,26 +2809,26 @@ func (x *SetProviderConfig_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetProviderConfig_Request.ProtoReflect.Descriptor instead.
-func (*SetProviderConfig_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use CancelTable_Request.ProtoReflect.Descriptor instead.
+func (*CancelTable_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{15, 0}
 }
 
-func (x *SetProviderConfig_Request) GetStorage() *Storage {
+func (x *CancelTable_Request) GetPullId() string {
 	if x != nil {
-		return x.Storage
+		return x.PullId
 	}
-	return nil
+	return ""
 }
 
-func (x *SetProviderConfig_Request) GetProviderConfig() string {
-	if x != nil && x.ProviderConfig != nil {
-		return *x.ProviderConfig
+func (x *CancelTable_Request) GetTable() string {
+	if x != nil {
+		return x.Table
 	}
 	return ""
 }
 
-type SetProviderConfig_Response struct {
+type CancelTable_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1509,23 +2836,23 @@ type SetProviderConfig_Response struct {
 	Diagnostics []*Diagnostic `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 }
 
-func (x *SetProviderConfig_Response) Reset() {
-	*x = SetProviderConfig_Response{}
+func (x *CancelTable_Response) Reset() {
+	*x = CancelTable_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[25]
+		mi := &file_grpc_internal_provider_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetProviderConfig_Response) String() string {
+func (x *CancelTable_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetProviderConfig_Response) ProtoMessage() {}
+func (*CancelTable_Response) ProtoMessage() {}
 
-func (x *SetProviderConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[25]
+func (x *CancelTable_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1536,45 +2863,43 @@ func (x *SetProviderConfig_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetProviderConfig_Response.ProtoReflect.Descriptor instead.
-func (*SetProviderConfig_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use CancelTable_Response.ProtoReflect.Descriptor instead.
+func (*CancelTable_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{15, 1}
 }
 
-func (x *SetProviderConfig_Response) GetDiagnostics() []*Diagnostic {
+func (x *CancelTable_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
 	return nil
 }
 
-type PullTables_Request struct {
+type GetPullStatus_Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tables        []string `protobuf:"bytes,1,rep,name=tables,proto3" json:"tables,omitempty"`
-	MaxGoroutines uint64   `protobuf:"varint,2,opt,name=max_goroutines,json=maxGoroutines,proto3" json:"max_goroutines,omitempty"`
-	Timeout       int64    `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	PullId string `protobuf:"bytes,1,opt,name=pull_id,json=pullId,proto3" json:"pull_id,omitempty"`
 }
 
-func (x *PullTables_Request) Reset() {
-	*x = PullTables_Request{}
+func (x *GetPullStatus_Request) Reset() {
+	*x = GetPullStatus_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[26]
+		mi := &file_grpc_internal_provider_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PullTables_Request) String() string {
+func (x *GetPullStatus_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullTables_Request) ProtoMessage() {}
+func (*GetPullStatus_Request) ProtoMessage() {}
 
-func (x *PullTables_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[26]
+func (x *GetPullStatus_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1585,62 +2910,55 @@ func (x *PullTables_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullTables_Request.ProtoReflect.Descriptor instead.
-func (*PullTables_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{11, 0}
-}
-
-func (x *PullTables_Request) GetTables() []string {
-	if x != nil {
-		return x.Tables
-	}
-	return nil
-}
-
-func (x *PullTables_Request) GetMaxGoroutines() uint64 {
-	if x != nil {
-		return x.MaxGoroutines
-	}
-	return 0
+// Deprecated: Use GetPullStatus_Request.ProtoReflect.Descriptor instead.
+func (*GetPullStatus_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *PullTables_Request) GetTimeout() int64 {
+func (x *GetPullStatus_Request) GetPullId() string {
 	if x != nil {
-		return x.Timeout
+		return x.PullId
 	}
-	return 0
+	return ""
 }
 
-type PullTables_Response struct {
+type GetPullStatus_Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// map of tables that have finished fetching
-	FinishedTables map[string]bool `protobuf:"bytes,1,rep,name=finished_tables,json=finishedTables,proto3" json:"finished_tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	// Amount of tables collected so far
-	TableCount  uint64        `protobuf:"varint,2,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
-	Table       string        `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
-	Diagnostics []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
-}
-
-func (x *PullTables_Response) Reset() {
-	*x = PullTables_Response{}
+	PullId string `protobuf:"bytes,1,opt,name=pull_id,json=pullId,proto3" json:"pull_id,omitempty"`
+	// How long the pull has been running, in milliseconds
+	ElapsedTime int64 `protobuf:"varint,2,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	// The tasks waiting in the queue
+	QueuedTaskCount uint64 `protobuf:"varint,3,opt,name=queued_task_count,json=queuedTaskCount,proto3" json:"queued_task_count,omitempty"`
+	// The tasks submitted but not done, include the queued and running tasks
+	UnfinishedTaskCount uint64 `protobuf:"varint,4,opt,name=unfinished_task_count,json=unfinishedTaskCount,proto3" json:"unfinished_task_count,omitempty"`
+	// <tableName, running task count>
+	RunningTaskCount map[string]uint64 `protobuf:"bytes,5,rep,name=running_task_count,json=runningTaskCount,proto3" json:"running_task_count,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	FinishedTables   map[string]bool   `protobuf:"bytes,6,rep,name=finished_tables,json=finishedTables,proto3" json:"finished_tables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TableCount       uint64            `protobuf:"varint,7,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	CancelledTables  []string          `protobuf:"bytes,8,rep,name=cancelled_tables,json=cancelledTables,proto3" json:"cancelled_tables,omitempty"`
+	Diagnostics      []*Diagnostic     `protobuf:"bytes,9,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *GetPullStatus_Response) Reset() {
+	*x = GetPullStatus_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[27]
+		mi := &file_grpc_internal_provider_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PullTables_Response) String() string {
+func (x *GetPullStatus_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PullTables_Response) ProtoMessage() {}
+func (*GetPullStatus_Response) ProtoMessage() {}
 
-func (x *PullTables_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[27]
+func (x *GetPullStatus_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1651,33 +2969,68 @@ func (x *PullTables_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PullTables_Response.ProtoReflect.Descriptor instead.
-func (*PullTables_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{11, 1}
+// Deprecated: Use GetPullStatus_Response.ProtoReflect.Descriptor instead.
+func (*GetPullStatus_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{16, 1}
 }
 
-func (x *PullTables_Response) GetFinishedTables() map[string]bool {
+func (x *GetPullStatus_Response) GetPullId() string {
+	if x != nil {
+		return x.PullId
+	}
+	return ""
+}
+
+func (x *GetPullStatus_Response) GetElapsedTime() int64 {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return 0
+}
+
+func (x *GetPullStatus_Response) GetQueuedTaskCount() uint64 {
+	if x != nil {
+		return x.QueuedTaskCount
+	}
+	return 0
+}
+
+func (x *GetPullStatus_Response) GetUnfinishedTaskCount() uint64 {
+	if x != nil {
+		return x.UnfinishedTaskCount
+	}
+	return 0
+}
+
+func (x *GetPullStatus_Response) GetRunningTaskCount() map[string]uint64 {
+	if x != nil {
+		return x.RunningTaskCount
+	}
+	return nil
+}
+
+func (x *GetPullStatus_Response) GetFinishedTables() map[string]bool {
 	if x != nil {
 		return x.FinishedTables
 	}
 	return nil
 }
 
-func (x *PullTables_Response) GetTableCount() uint64 {
+func (x *GetPullStatus_Response) GetTableCount() uint64 {
 	if x != nil {
 		return x.TableCount
 	}
 	return 0
 }
 
-func (x *PullTables_Response) GetTable() string {
+func (x *GetPullStatus_Response) GetCancelledTables() []string {
 	if x != nil {
-		return x.Table
+		return x.CancelledTables
 	}
-	return ""
+	return nil
 }
 
-func (x *PullTables_Response) GetDiagnostics() []*Diagnostic {
+func (x *GetPullStatus_Response) GetDiagnostics() []*Diagnostic {
 	if x != nil {
 		return x.Diagnostics
 	}
@@ -1693,7 +3046,7 @@ type DropTableAll_Request struct {
 func (x *DropTableAll_Request) Reset() {
 	*x = DropTableAll_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[29]
+		mi := &file_grpc_internal_provider_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1706,7 +3059,7 @@ func (x *DropTableAll_Request) String() string {
 func (*DropTableAll_Request) ProtoMessage() {}
 
 func (x *DropTableAll_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[29]
+	mi := &file_grpc_internal_provider_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1719,7 +3072,7 @@ func (x *DropTableAll_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropTableAll_Request.ProtoReflect.Descriptor instead.
 func (*DropTableAll_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{12, 0}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type DropTableAll_Response struct {
@@ -1733,7 +3086,7 @@ type DropTableAll_Response struct {
 func (x *DropTableAll_Response) Reset() {
 	*x = DropTableAll_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[30]
+		mi := &file_grpc_internal_provider_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1746,7 +3099,7 @@ func (x *DropTableAll_Response) String() string {
 func (*DropTableAll_Response) ProtoMessage() {}
 
 func (x *DropTableAll_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[30]
+	mi := &file_grpc_internal_provider_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1759,7 +3112,7 @@ func (x *DropTableAll_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DropTableAll_Response.ProtoReflect.Descriptor instead.
 func (*DropTableAll_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{12, 1}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{20, 1}
 }
 
 func (x *DropTableAll_Response) GetDiagnostics() []*Diagnostic {
@@ -1778,7 +3131,7 @@ type CreateAllTables_Request struct {
 func (x *CreateAllTables_Request) Reset() {
 	*x = CreateAllTables_Request{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[31]
+		mi := &file_grpc_internal_provider_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1791,7 +3144,7 @@ func (x *CreateAllTables_Request) String() string {
 func (*CreateAllTables_Request) ProtoMessage() {}
 
 func (x *CreateAllTables_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[31]
+	mi := &file_grpc_internal_provider_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1804,7 +3157,7 @@ func (x *CreateAllTables_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAllTables_Request.ProtoReflect.Descriptor instead.
 func (*CreateAllTables_Request) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{13, 0}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type CreateAllTables_Response struct {
@@ -1818,7 +3171,7 @@ type CreateAllTables_Response struct {
 func (x *CreateAllTables_Response) Reset() {
 	*x = CreateAllTables_Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_grpc_internal_provider_proto_msgTypes[32]
+		mi := &file_grpc_internal_provider_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1831,7 +3184,7 @@ func (x *CreateAllTables_Response) String() string {
 func (*CreateAllTables_Response) ProtoMessage() {}
 
 func (x *CreateAllTables_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_internal_provider_proto_msgTypes[32]
+	mi := &file_grpc_internal_provider_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1844,7 +3197,7 @@ func (x *CreateAllTables_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAllTables_Response.ProtoReflect.Descriptor instead.
 func (*CreateAllTables_Response) Descriptor() ([]byte, []int) {
-	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{13, 1}
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *CreateAllTables_Response) GetDiagnostics() []*Diagnostic {
@@ -1854,6 +3207,145 @@ func (x *CreateAllTables_Response) GetDiagnostics() []*Diagnostic {
 	return nil
 }
 
+type Query_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only one SELECT statement is allowed
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// max rows returned, the rest is truncated
+	RowLimit uint64 `protobuf:"varint,2,opt,name=row_limit,json=rowLimit,proto3" json:"row_limit,omitempty"`
+	// statement timeout, in milliseconds
+	Timeout int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *Query_Request) Reset() {
+	*x = Query_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Query_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query_Request) ProtoMessage() {}
+
+func (x *Query_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query_Request.ProtoReflect.Descriptor instead.
+func (*Query_Request) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *Query_Request) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *Query_Request) GetRowLimit() uint64 {
+	if x != nil {
+		return x.RowLimit
+	}
+	return 0
+}
+
+func (x *Query_Request) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type Query_Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// only the first response carry the column names
+	ColumnNames []string    `protobuf:"bytes,1,rep,name=column_names,json=columnNames,proto3" json:"column_names,omitempty"`
+	Rows        []*QueryRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	// the last response tell whether rows is truncated by row limit
+	IsTruncated bool          `protobuf:"varint,3,opt,name=is_truncated,json=isTruncated,proto3" json:"is_truncated,omitempty"`
+	Diagnostics []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+}
+
+func (x *Query_Response) Reset() {
+	*x = Query_Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_grpc_internal_provider_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Query_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Query_Response) ProtoMessage() {}
+
+func (x *Query_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_internal_provider_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Query_Response.ProtoReflect.Descriptor instead.
+func (*Query_Response) Descriptor() ([]byte, []int) {
+	return file_grpc_internal_provider_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *Query_Response) GetColumnNames() []string {
+	if x != nil {
+		return x.ColumnNames
+	}
+	return nil
+}
+
+func (x *Query_Response) GetRows() []*QueryRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *Query_Response) GetIsTruncated() bool {
+	if x != nil {
+		return x.IsTruncated
+	}
+	return false
+}
+
+func (x *Query_Response) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
 var File_grpc_internal_provider_proto protoreflect.FileDescriptor
 
 var file_grpc_internal_provider_proto_rawDesc = []byte{
@@ -1902,7 +3394,7 @@ var file_grpc_internal_provider_proto_rawDesc = []byte{
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd6, 0x01, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xea, 0x01, 0x0a, 0x05, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
 	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1d, 0x0a,
 	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
@@ -1915,185 +3407,465 @@ var file_grpc_internal_provider_proto_rawDesc = []byte{
 	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x73, 0x75, 0x62, 0x54, 0x61,
 	0x62, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x65,
-	0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f,
-	0x6c, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x5f, 0x65,
-	0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x3c, 0x0a, 0x0c, 0x52, 0x65, 0x73,
-	0x6f, 0x6c, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x22, 0x70, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x73, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
-	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a,
-	0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x85, 0x01, 0x0a, 0x08, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x33, 0x0a,
-	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
-	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
-	0x63, 0x73, 0x22, 0x71, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x1a, 0x21, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f,
-	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x86, 0x01, 0x0a, 0x07,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x01, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
-	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x5a, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x85, 0x03, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x1a, 0x62, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x6f, 0x72, 0x6f, 0x75,
-	0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78,
-	0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x1a, 0x92, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x57, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x66, 0x69, 0x6e, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x22, 0x65, 0x0a, 0x06, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x0a, 0x43, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x08,
+	0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x22, 0x3c, 0x0a,
+	0x0c, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x22, 0x70, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa6, 0x01,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x85,
+	0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
 	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
 	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
-	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68,
-	0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x0c, 0x44, 0x72, 0x6f,
-	0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x4e, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x72, 0x6f,
+	0x62, 0x65, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0xbf, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x11, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x86,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x5a, 0x0a, 0x07, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xed, 0x08, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x1a, 0xf4, 0x04, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x47, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x2e,
+	0x0a, 0x13, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x35,
+	0x0a, 0x17, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74,
+	0x61, 0x73, 0x6b, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x61, 0x73, 0x6b,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x59, 0x0a, 0x10, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x1a, 0x5a,
+	0x0a, 0x14, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe7, 0x03, 0x0a, 0x08, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x5a, 0x0a, 0x10,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x6c, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x6c, 0x6c, 0x49,
+	0x64, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5e, 0x0a, 0x14, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x9b, 0x01, 0x0a, 0x0f, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x4c, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x71, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x75, 0x6c, 0x6c,
+	0x1a, 0x22, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x75, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75,
+	0x6c, 0x6c, 0x49, 0x64, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69,
 	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
-	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x33, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61,
-	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x22, 0x9b, 0x02, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
-	0x74, 0x69, 0x63, 0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
-	0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e,
-	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
-	0x0f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x44,
-	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x17,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x38, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x75, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x1a,
+	0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x22, 0xa4, 0x05, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x1a, 0x22, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x70, 0x75, 0x6c, 0x6c, 0x49, 0x64, 0x1a, 0xee, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x75,
+	0x6e, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x6e, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x61, 0x0a, 0x12, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x10, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x29, 0x0a, 0x10, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x6c, 0x65, 0x64, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74,
+	0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a,
+	0x43, 0x0a, 0x15, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd1, 0x03, 0x0a, 0x0c, 0x50, 0x75, 0x6c, 0x6c,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x61,
+	0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x61, 0x77, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x30, 0x0a, 0x14, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x1b,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x18, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x14,
+	0x50, 0x75, 0x6c, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x63,
+	0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x69, 0x73, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x4f, 0x70, 0x65, 0x6e,
+	0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xf2, 0x02, 0x0a, 0x13, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x68, 0x72,
+	0x6f, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x57,
+	0x61, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x75,
+	0x74, 0x5f, 0x6f, 0x66, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x43,
+	0x75, 0x74, 0x4f, 0x66, 0x66, 0x12, 0x5d, 0x0a, 0x11, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x1a, 0x60, 0x0a, 0x15, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x22, 0x5d, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x1a, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3f, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0b,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x22, 0x8c, 0x02, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x56, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09,
+	0x72, 0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x1a, 0xaa, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x74,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x0b, 0x64,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x74, 0x69, 0x63, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73,
+	0x22, 0x35, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x12, 0x29, 0x0a, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x19, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6e, 0x75, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x06, 0x69, 0x73, 0x4e, 0x75, 0x6c,
+	0x6c, 0x12, 0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x21, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x29, 0x0a, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x6a,
+	0x73, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0xc5, 0x03, 0x0a, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x12, 0x4b, 0x0a, 0x0f, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x2e, 0x44, 0x69, 0x61,
+	0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x0f, 0x64, 0x69,
+	0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x48, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xa5, 0x01, 0x0a, 0x0f, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x65, 0x10, 0x00, 0x12, 0x17,
 	0x0a, 0x13, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c,
-	0x54, 0x72, 0x61, 0x63, 0x65, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x69, 0x61, 0x67, 0x6e,
-	0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x01,
-	0x12, 0x16, 0x0a, 0x12, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x69, 0x61, 0x67,
-	0x6e, 0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x03,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x57, 0x61, 0x72, 0x6e, 0x10, 0x03, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x69, 0x61, 0x67, 0x6e,
+	0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x04,
 	0x12, 0x17, 0x0a, 0x13, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76,
-	0x65, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x69, 0x61,
-	0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x46, 0x61, 0x74, 0x61, 0x6c,
-	0x10, 0x05, 0x2a, 0x97, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x4d, 0x41, 0x4c,
-	0x4c, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x12,
-	0x0a, 0x0a, 0x06, 0x42, 0x49, 0x47, 0x49, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x46,
-	0x4c, 0x4f, 0x41, 0x54, 0x10, 0x05, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x10, 0x06,
-	0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a,
-	0x42, 0x59, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c,
-	0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x09, 0x12, 0x0d,
-	0x0a, 0x09, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x0a, 0x12, 0x0d, 0x0a,
-	0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d, 0x50, 0x10, 0x0b, 0x12, 0x08, 0x0a, 0x04,
-	0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x41,
-	0x52, 0x52, 0x41, 0x59, 0x10, 0x0d, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x45, 0x54, 0x10, 0x0e,
-	0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x45, 0x54, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x0f,
-	0x12, 0x08, 0x0a, 0x04, 0x43, 0x49, 0x44, 0x52, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x49,
-	0x44, 0x52, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x11, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41,
-	0x43, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x43, 0x5f,
-	0x41, 0x44, 0x44, 0x52, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x13, 0x2a, 0x32, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f,
-	0x0a, 0x0b, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x00, 0x12,
-	0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47, 0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01,
-	0x2a, 0x28, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52, 0x45, 0x53, 0x51, 0x4c, 0x10, 0x00, 0x12,
-	0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x32, 0xd0, 0x04, 0x0a, 0x08, 0x50,
-	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
-	0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x69,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72,
+	0x65, 0x6c, 0x46, 0x61, 0x74, 0x61, 0x6c, 0x10, 0x05, 0x2a, 0x97, 0x02, 0x0a, 0x0a, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x4d, 0x41, 0x4c, 0x4c, 0x49, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x07, 0x0a,
+	0x03, 0x49, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x47, 0x49, 0x4e, 0x54,
+	0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x10, 0x05, 0x12, 0x08, 0x0a,
+	0x04, 0x55, 0x55, 0x49, 0x44, 0x10, 0x06, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x54, 0x52, 0x49, 0x4e,
+	0x47, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x52, 0x41,
+	0x59, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x41, 0x52,
+	0x52, 0x41, 0x59, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4e, 0x54, 0x5f, 0x41, 0x52, 0x52,
+	0x41, 0x59, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x54, 0x41, 0x4d,
+	0x50, 0x10, 0x0b, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x0e, 0x0a,
+	0x0a, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x0d, 0x12, 0x08, 0x0a,
+	0x04, 0x49, 0x4e, 0x45, 0x54, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x45, 0x54, 0x5f,
+	0x41, 0x52, 0x52, 0x41, 0x59, 0x10, 0x0f, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x49, 0x44, 0x52, 0x10,
+	0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x49, 0x44, 0x52, 0x5f, 0x41, 0x52, 0x52, 0x41, 0x59, 0x10,
+	0x11, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x43, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x10, 0x12, 0x12,
+	0x12, 0x0a, 0x0e, 0x4d, 0x41, 0x43, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x5f, 0x41, 0x52, 0x52, 0x41,
+	0x59, 0x10, 0x13, 0x2a, 0x32, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x52, 0x49, 0x4d, 0x41, 0x52, 0x59,
+	0x5f, 0x4b, 0x45, 0x59, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x45, 0x49, 0x47,
+	0x4e, 0x5f, 0x4b, 0x45, 0x59, 0x10, 0x01, 0x2a, 0x28, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x4f, 0x53, 0x54, 0x47, 0x52,
+	0x45, 0x53, 0x51, 0x4c, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10,
+	0x01, 0x32, 0xff, 0x07, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x41,
+	0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x69, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x67, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
-	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a,
-	0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72,
-	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x54,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75,
-	0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62,
-	0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x49,
-	0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x1b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x6c, 0x6c, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0b, 0x5a,
-	0x09, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69,
+	0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45,
+	0x0a, 0x0a, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x72,
+	0x6f, 0x70, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x41, 0x6c, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0a,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x75, 0x6c, 0x6c, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x75, 0x6c, 0x6c, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x75, 0x6c, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75,
+	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x6c, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x0b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x0b, 0x5a, 0x09, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2109,7 +3881,7 @@ func file_grpc_internal_provider_proto_rawDescGZIP() []byte {
 }
 
 var file_grpc_internal_provider_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_grpc_internal_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_grpc_internal_provider_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_grpc_internal_provider_proto_goTypes = []interface{}{
 	(ColumnType)(0),                         // 0: proto.ColumnType
 	(ConstraintType)(0),                     // 1: proto.ConstraintType
@@ -2124,30 +3896,59 @@ var file_grpc_internal_provider_proto_goTypes = []interface{}{
 	(*Constraint)(nil),                      // 10: proto.Constraint
 	(*GetProviderConfig)(nil),               // 11: proto.GetProviderConfig
 	(*CheckConfig)(nil),                     // 12: proto.CheckConfig
-	(*SetProviderConfig)(nil),               // 13: proto.SetProviderConfig
-	(*Storage)(nil),                         // 14: proto.Storage
-	(*PullTables)(nil),                      // 15: proto.PullTables
-	(*DropTableAll)(nil),                    // 16: proto.DropTableAll
-	(*CreateAllTables)(nil),                 // 17: proto.CreateAllTables
-	(*Diagnostic)(nil),                      // 18: proto.Diagnostic
-	(*ProviderInit_Request)(nil),            // 19: proto.ProviderInit.Request
-	(*ProviderInit_Response)(nil),           // 20: proto.ProviderInit.Response
-	(*GetProviderInformation_Request)(nil),  // 21: proto.GetProviderInformation.Request
-	(*GetProviderInformation_Response)(nil), // 22: proto.GetProviderInformation.Response
-	nil,                                     // 23: proto.GetProviderInformation.Response.TablesEntry
-	(*GetProviderConfig_Request)(nil),       // 24: proto.GetProviderConfig.Request
-	(*GetProviderConfig_Response)(nil),      // 25: proto.GetProviderConfig.Response
-	(*CheckConfig_Request)(nil),             // 26: proto.CheckConfig.Request
-	(*CheckConfig_Response)(nil),            // 27: proto.CheckConfig.Response
-	(*SetProviderConfig_Request)(nil),       // 28: proto.SetProviderConfig.Request
-	(*SetProviderConfig_Response)(nil),      // 29: proto.SetProviderConfig.Response
-	(*PullTables_Request)(nil),              // 30: proto.PullTables.Request
-	(*PullTables_Response)(nil),             // 31: proto.PullTables.Response
-	nil,                                     // 32: proto.PullTables.Response.FinishedTablesEntry
-	(*DropTableAll_Request)(nil),            // 33: proto.DropTableAll.Request
-	(*DropTableAll_Response)(nil),           // 34: proto.DropTableAll.Response
-	(*CreateAllTables_Request)(nil),         // 35: proto.CreateAllTables.Request
-	(*CreateAllTables_Response)(nil),        // 36: proto.CreateAllTables.Response
+	(*GetCapabilities)(nil),                 // 13: proto.GetCapabilities
+	(*SetProviderConfig)(nil),               // 14: proto.SetProviderConfig
+	(*Storage)(nil),                         // 15: proto.Storage
+	(*PullTables)(nil),                      // 16: proto.PullTables
+	(*TableParameters)(nil),                 // 17: proto.TableParameters
+	(*CancelPull)(nil),                      // 18: proto.CancelPull
+	(*CancelTable)(nil),                     // 19: proto.CancelTable
+	(*GetPullStatus)(nil),                   // 20: proto.GetPullStatus
+	(*PullCounters)(nil),                    // 21: proto.PullCounters
+	(*PullClientStatistics)(nil),            // 22: proto.PullClientStatistics
+	(*PullTableStatistics)(nil),             // 23: proto.PullTableStatistics
+	(*DropTableAll)(nil),                    // 24: proto.DropTableAll
+	(*CreateAllTables)(nil),                 // 25: proto.CreateAllTables
+	(*Query)(nil),                           // 26: proto.Query
+	(*QueryRow)(nil),                        // 27: proto.QueryRow
+	(*QueryValue)(nil),                      // 28: proto.QueryValue
+	(*Diagnostic)(nil),                      // 29: proto.Diagnostic
+	(*ProviderInit_Request)(nil),            // 30: proto.ProviderInit.Request
+	(*ProviderInit_Response)(nil),           // 31: proto.ProviderInit.Response
+	(*GetProviderInformation_Request)(nil),  // 32: proto.GetProviderInformation.Request
+	(*GetProviderInformation_Response)(nil), // 33: proto.GetProviderInformation.Response
+	nil,                                     // 34: proto.GetProviderInformation.Response.TablesEntry
+	(*GetProviderConfig_Request)(nil),       // 35: proto.GetProviderConfig.Request
+	(*GetProviderConfig_Response)(nil),      // 36: proto.GetProviderConfig.Response
+	(*CheckConfig_Request)(nil),             // 37: proto.CheckConfig.Request
+	(*CheckConfig_Response)(nil),            // 38: proto.CheckConfig.Response
+	(*GetCapabilities_Request)(nil),         // 39: proto.GetCapabilities.Request
+	(*GetCapabilities_Response)(nil),        // 40: proto.GetCapabilities.Response
+	(*SetProviderConfig_Request)(nil),       // 41: proto.SetProviderConfig.Request
+	(*SetProviderConfig_Response)(nil),      // 42: proto.SetProviderConfig.Response
+	(*PullTables_Request)(nil),              // 43: proto.PullTables.Request
+	(*PullTables_Response)(nil),             // 44: proto.PullTables.Response
+	nil,                                     // 45: proto.PullTables.Request.TableParametersEntry
+	nil,                                     // 46: proto.PullTables.Response.FinishedTablesEntry
+	nil,                                     // 47: proto.PullTables.Response.TableStatisticsEntry
+	nil,                                     // 48: proto.TableParameters.ValuesEntry
+	(*CancelPull_Request)(nil),              // 49: proto.CancelPull.Request
+	(*CancelPull_Response)(nil),             // 50: proto.CancelPull.Response
+	(*CancelTable_Request)(nil),             // 51: proto.CancelTable.Request
+	(*CancelTable_Response)(nil),            // 52: proto.CancelTable.Response
+	(*GetPullStatus_Request)(nil),           // 53: proto.GetPullStatus.Request
+	(*GetPullStatus_Response)(nil),          // 54: proto.GetPullStatus.Response
+	nil,                                     // 55: proto.GetPullStatus.Response.RunningTaskCountEntry
+	nil,                                     // 56: proto.GetPullStatus.Response.FinishedTablesEntry
+	nil,                                     // 57: proto.PullClientStatistics.ClientLabelsEntry
+	nil,                                     // 58: proto.PullTableStatistics.ClientStatisticsEntry
+	(*DropTableAll_Request)(nil),            // 59: proto.DropTableAll.Request
+	(*DropTableAll_Response)(nil),           // 60: proto.DropTableAll.Response
+	(*CreateAllTables_Request)(nil),         // 61: proto.CreateAllTables.Request
+	(*CreateAllTables_Response)(nil),        // 62: proto.CreateAllTables.Response
+	(*Query_Request)(nil),                   // 63: proto.Query.Request
+	(*Query_Response)(nil),                  // 64: proto.Query.Response
+	nil,                                     // 65: proto.Diagnostic.ClientLabelsEntry
 }
 var file_grpc_internal_provider_proto_depIdxs = []int32{
 	7,  // 0: proto.Table.columns:type_name -> proto.Column
@@ -2156,39 +3957,73 @@ var file_grpc_internal_provider_proto_depIdxs = []int32{
 	9,  // 3: proto.ColumnMeta.resolver:type_name -> proto.ResolverMeta
 	1,  // 4: proto.Constraint.type:type_name -> proto.ConstraintType
 	2,  // 5: proto.Storage.type:type_name -> proto.StorageType
-	3,  // 6: proto.Diagnostic.diagnosticLevel:type_name -> proto.Diagnostic.DiagnosticLevel
-	14, // 7: proto.ProviderInit.Request.storage:type_name -> proto.Storage
-	18, // 8: proto.ProviderInit.Response.diagnostics:type_name -> proto.Diagnostic
-	23, // 9: proto.GetProviderInformation.Response.tables:type_name -> proto.GetProviderInformation.Response.TablesEntry
-	18, // 10: proto.GetProviderInformation.Response.diagnostics:type_name -> proto.Diagnostic
-	6,  // 11: proto.GetProviderInformation.Response.TablesEntry.value:type_name -> proto.Table
-	18, // 12: proto.GetProviderConfig.Response.diagnostics:type_name -> proto.Diagnostic
-	18, // 13: proto.CheckConfig.Response.diagnostics:type_name -> proto.Diagnostic
-	14, // 14: proto.SetProviderConfig.Request.storage:type_name -> proto.Storage
-	18, // 15: proto.SetProviderConfig.Response.diagnostics:type_name -> proto.Diagnostic
-	32, // 16: proto.PullTables.Response.finished_tables:type_name -> proto.PullTables.Response.FinishedTablesEntry
-	18, // 17: proto.PullTables.Response.diagnostics:type_name -> proto.Diagnostic
-	18, // 18: proto.DropTableAll.Response.diagnostics:type_name -> proto.Diagnostic
-	18, // 19: proto.CreateAllTables.Response.diagnostics:type_name -> proto.Diagnostic
-	19, // 20: proto.Provider.Init:input_type -> proto.ProviderInit.Request
-	21, // 21: proto.Provider.GetProviderInformation:input_type -> proto.GetProviderInformation.Request
-	24, // 22: proto.Provider.GetProviderConfig:input_type -> proto.GetProviderConfig.Request
-	28, // 23: proto.Provider.SetProviderConfig:input_type -> proto.SetProviderConfig.Request
-	30, // 24: proto.Provider.PullTables:input_type -> proto.PullTables.Request
-	33, // 25: proto.Provider.DropTableAll:input_type -> proto.DropTableAll.Request
-	35, // 26: proto.Provider.CreateAllTables:input_type -> proto.CreateAllTables.Request
-	20, // 27: proto.Provider.Init:output_type -> proto.ProviderInit.Response
-	22, // 28: proto.Provider.GetProviderInformation:output_type -> proto.GetProviderInformation.Response
-	25, // 29: proto.Provider.GetProviderConfig:output_type -> proto.GetProviderConfig.Response
-	29, // 30: proto.Provider.SetProviderConfig:output_type -> proto.SetProviderConfig.Response
-	31, // 31: proto.Provider.PullTables:output_type -> proto.PullTables.Response
-	34, // 32: proto.Provider.DropTableAll:output_type -> proto.DropTableAll.Response
-	36, // 33: proto.Provider.CreateAllTables:output_type -> proto.CreateAllTables.Response
-	27, // [27:34] is the sub-list for method output_type
-	20, // [20:27] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	48, // 6: proto.TableParameters.values:type_name -> proto.TableParameters.ValuesEntry
+	21, // 7: proto.PullClientStatistics.counters:type_name -> proto.PullCounters
+	57, // 8: proto.PullClientStatistics.client_labels:type_name -> proto.PullClientStatistics.ClientLabelsEntry
+	21, // 9: proto.PullTableStatistics.counters:type_name -> proto.PullCounters
+	58, // 10: proto.PullTableStatistics.client_statistics:type_name -> proto.PullTableStatistics.ClientStatisticsEntry
+	28, // 11: proto.QueryRow.values:type_name -> proto.QueryValue
+	3,  // 12: proto.Diagnostic.diagnosticLevel:type_name -> proto.Diagnostic.DiagnosticLevel
+	65, // 13: proto.Diagnostic.client_labels:type_name -> proto.Diagnostic.ClientLabelsEntry
+	15, // 14: proto.ProviderInit.Request.storage:type_name -> proto.Storage
+	29, // 15: proto.ProviderInit.Response.diagnostics:type_name -> proto.Diagnostic
+	34, // 16: proto.GetProviderInformation.Response.tables:type_name -> proto.GetProviderInformation.Response.TablesEntry
+	29, // 17: proto.GetProviderInformation.Response.diagnostics:type_name -> proto.Diagnostic
+	6,  // 18: proto.GetProviderInformation.Response.TablesEntry.value:type_name -> proto.Table
+	29, // 19: proto.GetProviderConfig.Response.diagnostics:type_name -> proto.Diagnostic
+	29, // 20: proto.CheckConfig.Response.diagnostics:type_name -> proto.Diagnostic
+	2,  // 21: proto.GetCapabilities.Response.storage_types:type_name -> proto.StorageType
+	29, // 22: proto.GetCapabilities.Response.diagnostics:type_name -> proto.Diagnostic
+	15, // 23: proto.SetProviderConfig.Request.storage:type_name -> proto.Storage
+	29, // 24: proto.SetProviderConfig.Response.diagnostics:type_name -> proto.Diagnostic
+	45, // 25: proto.PullTables.Request.table_parameters:type_name -> proto.PullTables.Request.TableParametersEntry
+	46, // 26: proto.PullTables.Response.finished_tables:type_name -> proto.PullTables.Response.FinishedTablesEntry
+	29, // 27: proto.PullTables.Response.diagnostics:type_name -> proto.Diagnostic
+	47, // 28: proto.PullTables.Response.table_statistics:type_name -> proto.PullTables.Response.TableStatisticsEntry
+	17, // 29: proto.PullTables.Request.TableParametersEntry.value:type_name -> proto.TableParameters
+	23, // 30: proto.PullTables.Response.TableStatisticsEntry.value:type_name -> proto.PullTableStatistics
+	28, // 31: proto.TableParameters.ValuesEntry.value:type_name -> proto.QueryValue
+	29, // 32: proto.CancelPull.Response.diagnostics:type_name -> proto.Diagnostic
+	29, // 33: proto.CancelTable.Response.diagnostics:type_name -> proto.Diagnostic
+	55, // 34: proto.GetPullStatus.Response.running_task_count:type_name -> proto.GetPullStatus.Response.RunningTaskCountEntry
+	56, // 35: proto.GetPullStatus.Response.finished_tables:type_name -> proto.GetPullStatus.Response.FinishedTablesEntry
+	29, // 36: proto.GetPullStatus.Response.diagnostics:type_name -> proto.Diagnostic
+	22, // 37: proto.PullTableStatistics.ClientStatisticsEntry.value:type_name -> proto.PullClientStatistics
+	29, // 38: proto.DropTableAll.Response.diagnostics:type_name -> proto.Diagnostic
+	29, // 39: proto.CreateAllTables.Response.diagnostics:type_name -> proto.Diagnostic
+	27, // 40: proto.Query.Response.rows:type_name -> proto.QueryRow
+	29, // 41: proto.Query.Response.diagnostics:type_name -> proto.Diagnostic
+	30, // 42: proto.Provider.Init:input_type -> proto.ProviderInit.Request
+	32, // 43: proto.Provider.GetProviderInformation:input_type -> proto.GetProviderInformation.Request
+	35, // 44: proto.Provider.GetProviderConfig:input_type -> proto.GetProviderConfig.Request
+	41, // 45: proto.Provider.SetProviderConfig:input_type -> proto.SetProviderConfig.Request
+	43, // 46: proto.Provider.PullTables:input_type -> proto.PullTables.Request
+	59, // 47: proto.Provider.DropTableAll:input_type -> proto.DropTableAll.Request
+	61, // 48: proto.Provider.CreateAllTables:input_type -> proto.CreateAllTables.Request
+	63, // 49: proto.Provider.Query:input_type -> proto.Query.Request
+	49, // 50: proto.Provider.CancelPull:input_type -> proto.CancelPull.Request
+	53, // 51: proto.Provider.GetPullStatus:input_type -> proto.GetPullStatus.Request
+	51, // 52: proto.Provider.CancelTable:input_type -> proto.CancelTable.Request
+	37, // 53: proto.Provider.CheckConfig:input_type -> proto.CheckConfig.Request
+	39, // 54: proto.Provider.GetCapabilities:input_type -> proto.GetCapabilities.Request
+	31, // 55: proto.Provider.Init:output_type -> proto.ProviderInit.Response
+	33, // 56: proto.Provider.GetProviderInformation:output_type -> proto.GetProviderInformation.Response
+	36, // 57: proto.Provider.GetProviderConfig:output_type -> proto.GetProviderConfig.Response
+	42, // 58: proto.Provider.SetProviderConfig:output_type -> proto.SetProviderConfig.Response
+	44, // 59: proto.Provider.PullTables:output_type -> proto.PullTables.Response
+	60, // 60: proto.Provider.DropTableAll:output_type -> proto.DropTableAll.Response
+	62, // 61: proto.Provider.CreateAllTables:output_type -> proto.CreateAllTables.Response
+	64, // 62: proto.Provider.Query:output_type -> proto.Query.Response
+	50, // 63: proto.Provider.CancelPull:output_type -> proto.CancelPull.Response
+	54, // 64: proto.Provider.GetPullStatus:output_type -> proto.GetPullStatus.Response
+	52, // 65: proto.Provider.CancelTable:output_type -> proto.CancelTable.Response
+	38, // 66: proto.Provider.CheckConfig:output_type -> proto.CheckConfig.Response
+	40, // 67: proto.Provider.GetCapabilities:output_type -> proto.GetCapabilities.Response
+	55, // [55:68] is the sub-list for method output_type
+	42, // [42:55] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_grpc_internal_provider_proto_init() }
@@ -2306,7 +4141,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProviderConfig); i {
+			switch v := v.(*GetCapabilities); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2318,7 +4153,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Storage); i {
+			switch v := v.(*SetProviderConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2330,7 +4165,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullTables); i {
+			switch v := v.(*Storage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2342,7 +4177,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DropTableAll); i {
+			switch v := v.(*PullTables); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2354,7 +4189,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAllTables); i {
+			switch v := v.(*TableParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2366,7 +4201,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Diagnostic); i {
+			switch v := v.(*CancelPull); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2378,7 +4213,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderInit_Request); i {
+			switch v := v.(*CancelTable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2390,7 +4225,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProviderInit_Response); i {
+			switch v := v.(*GetPullStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2402,7 +4237,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProviderInformation_Request); i {
+			switch v := v.(*PullCounters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2414,7 +4249,19 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProviderInformation_Response); i {
+			switch v := v.(*PullClientStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTableStatistics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2426,7 +4273,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProviderConfig_Request); i {
+			switch v := v.(*DropTableAll); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2438,7 +4285,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProviderConfig_Response); i {
+			switch v := v.(*CreateAllTables); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2450,7 +4297,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckConfig_Request); i {
+			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2462,7 +4309,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckConfig_Response); i {
+			switch v := v.(*QueryRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2474,7 +4321,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProviderConfig_Request); i {
+			switch v := v.(*QueryValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2486,7 +4333,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetProviderConfig_Response); i {
+			switch v := v.(*Diagnostic); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2498,7 +4345,7 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullTables_Request); i {
+			switch v := v.(*ProviderInit_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2510,7 +4357,19 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PullTables_Response); i {
+			switch v := v.(*ProviderInit_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProviderInformation_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2522,6 +4381,210 @@ func file_grpc_internal_provider_proto_init() {
 			}
 		}
 		file_grpc_internal_provider_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProviderInformation_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProviderConfig_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProviderConfig_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConfig_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckConfig_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCapabilities_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCapabilities_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProviderConfig_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProviderConfig_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTables_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PullTables_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPull_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPull_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTable_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTable_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPullStatus_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPullStatus_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DropTableAll_Request); i {
 			case 0:
 				return &v.state
@@ -2533,7 +4596,7 @@ func file_grpc_internal_provider_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_internal_provider_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_grpc_internal_provider_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DropTableAll_Response); i {
 			case 0:
 				return &v.state
@@ -2545,7 +4608,7 @@ func file_grpc_internal_provider_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_internal_provider_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_grpc_internal_provider_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateAllTables_Request); i {
 			case 0:
 				return &v.state
@@ -2557,7 +4620,7 @@ func file_grpc_internal_provider_proto_init() {
 				return nil
 			}
 		}
-		file_grpc_internal_provider_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_grpc_internal_provider_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateAllTables_Response); i {
 			case 0:
 				return &v.state
@@ -2569,16 +4632,50 @@ func file_grpc_internal_provider_proto_init() {
 				return nil
 			}
 		}
+		file_grpc_internal_provider_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Query_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_grpc_internal_provider_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Query_Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_grpc_internal_provider_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*QueryValue_IsNull)(nil),
+		(*QueryValue_BoolValue)(nil),
+		(*QueryValue_IntValue)(nil),
+		(*QueryValue_FloatValue)(nil),
+		(*QueryValue_StringValue)(nil),
+		(*QueryValue_BytesValue)(nil),
+		(*QueryValue_TimestampValue)(nil),
+		(*QueryValue_JsonValue)(nil),
 	}
-	file_grpc_internal_provider_proto_msgTypes[15].OneofWrappers = []interface{}{}
-	file_grpc_internal_provider_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_grpc_internal_provider_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_grpc_internal_provider_proto_msgTypes[37].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_grpc_internal_provider_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   33,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
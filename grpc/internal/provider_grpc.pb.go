@@ -29,6 +29,13 @@ type ProviderClient interface {
 	PullTables(ctx context.Context, in *PullTables_Request, opts ...grpc.CallOption) (Provider_PullTablesClient, error)
 	DropTableAll(ctx context.Context, in *DropTableAll_Request, opts ...grpc.CallOption) (*DropTableAll_Response, error)
 	CreateAllTables(ctx context.Context, in *CreateAllTables_Request, opts ...grpc.CallOption) (*CreateAllTables_Response, error)
+	Query(ctx context.Context, in *Query_Request, opts ...grpc.CallOption) (Provider_QueryClient, error)
+	CancelPull(ctx context.Context, in *CancelPull_Request, opts ...grpc.CallOption) (*CancelPull_Response, error)
+	GetPullStatus(ctx context.Context, in *GetPullStatus_Request, opts ...grpc.CallOption) (*GetPullStatus_Response, error)
+	CancelTable(ctx context.Context, in *CancelTable_Request, opts ...grpc.CallOption) (*CancelTable_Response, error)
+	CheckConfig(ctx context.Context, in *CheckConfig_Request, opts ...grpc.CallOption) (*CheckConfig_Response, error)
+	// since protocol version 2
+	GetCapabilities(ctx context.Context, in *GetCapabilities_Request, opts ...grpc.CallOption) (*GetCapabilities_Response, error)
 }
 
 type providerClient struct {
@@ -125,6 +132,83 @@ func (c *providerClient) CreateAllTables(ctx context.Context, in *CreateAllTable
 	return out, nil
 }
 
+func (c *providerClient) Query(ctx context.Context, in *Query_Request, opts ...grpc.CallOption) (Provider_QueryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Provider_ServiceDesc.Streams[1], "/proto.Provider/Query", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &providerQueryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Provider_QueryClient interface {
+	Recv() (*Query_Response, error)
+	grpc.ClientStream
+}
+
+type providerQueryClient struct {
+	grpc.ClientStream
+}
+
+func (x *providerQueryClient) Recv() (*Query_Response, error) {
+	m := new(Query_Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *providerClient) CancelPull(ctx context.Context, in *CancelPull_Request, opts ...grpc.CallOption) (*CancelPull_Response, error) {
+	out := new(CancelPull_Response)
+	err := c.cc.Invoke(ctx, "/proto.Provider/CancelPull", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetPullStatus(ctx context.Context, in *GetPullStatus_Request, opts ...grpc.CallOption) (*GetPullStatus_Response, error) {
+	out := new(GetPullStatus_Response)
+	err := c.cc.Invoke(ctx, "/proto.Provider/GetPullStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CancelTable(ctx context.Context, in *CancelTable_Request, opts ...grpc.CallOption) (*CancelTable_Response, error) {
+	out := new(CancelTable_Response)
+	err := c.cc.Invoke(ctx, "/proto.Provider/CancelTable", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) CheckConfig(ctx context.Context, in *CheckConfig_Request, opts ...grpc.CallOption) (*CheckConfig_Response, error) {
+	out := new(CheckConfig_Response)
+	err := c.cc.Invoke(ctx, "/proto.Provider/CheckConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *providerClient) GetCapabilities(ctx context.Context, in *GetCapabilities_Request, opts ...grpc.CallOption) (*GetCapabilities_Response, error) {
+	out := new(GetCapabilities_Response)
+	err := c.cc.Invoke(ctx, "/proto.Provider/GetCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProviderServer is the server API for Provider service.
 // All implementations must embed UnimplementedProviderServer
 // for forward compatibility
@@ -136,6 +220,13 @@ type ProviderServer interface {
 	PullTables(*PullTables_Request, Provider_PullTablesServer) error
 	DropTableAll(context.Context, *DropTableAll_Request) (*DropTableAll_Response, error)
 	CreateAllTables(context.Context, *CreateAllTables_Request) (*CreateAllTables_Response, error)
+	Query(*Query_Request, Provider_QueryServer) error
+	CancelPull(context.Context, *CancelPull_Request) (*CancelPull_Response, error)
+	GetPullStatus(context.Context, *GetPullStatus_Request) (*GetPullStatus_Response, error)
+	CancelTable(context.Context, *CancelTable_Request) (*CancelTable_Response, error)
+	CheckConfig(context.Context, *CheckConfig_Request) (*CheckConfig_Response, error)
+	// since protocol version 2
+	GetCapabilities(context.Context, *GetCapabilities_Request) (*GetCapabilities_Response, error)
 	mustEmbedUnimplementedProviderServer()
 }
 
@@ -164,6 +255,24 @@ func (UnimplementedProviderServer) DropTableAll(context.Context, *DropTableAll_R
 func (UnimplementedProviderServer) CreateAllTables(context.Context, *CreateAllTables_Request) (*CreateAllTables_Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAllTables not implemented")
 }
+func (UnimplementedProviderServer) Query(*Query_Request, Provider_QueryServer) error {
+	return status.Errorf(codes.Unimplemented, "method Query not implemented")
+}
+func (UnimplementedProviderServer) CancelPull(context.Context, *CancelPull_Request) (*CancelPull_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPull not implemented")
+}
+func (UnimplementedProviderServer) GetPullStatus(context.Context, *GetPullStatus_Request) (*GetPullStatus_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPullStatus not implemented")
+}
+func (UnimplementedProviderServer) CancelTable(context.Context, *CancelTable_Request) (*CancelTable_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTable not implemented")
+}
+func (UnimplementedProviderServer) CheckConfig(context.Context, *CheckConfig_Request) (*CheckConfig_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConfig not implemented")
+}
+func (UnimplementedProviderServer) GetCapabilities(context.Context, *GetCapabilities_Request) (*GetCapabilities_Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedProviderServer) mustEmbedUnimplementedProviderServer() {}
 
 // UnsafeProviderServer may be embedded to opt out of forward compatibility for this service.
@@ -306,6 +415,117 @@ func _Provider_CreateAllTables_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Provider_Query_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Query_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProviderServer).Query(m, &providerQueryServer{stream})
+}
+
+type Provider_QueryServer interface {
+	Send(*Query_Response) error
+	grpc.ServerStream
+}
+
+type providerQueryServer struct {
+	grpc.ServerStream
+}
+
+func (x *providerQueryServer) Send(m *Query_Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Provider_CancelPull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPull_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CancelPull(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Provider/CancelPull",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CancelPull(ctx, req.(*CancelPull_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetPullStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullStatus_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetPullStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Provider/GetPullStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetPullStatus(ctx, req.(*GetPullStatus_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CancelTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTable_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CancelTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Provider/CancelTable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CancelTable(ctx, req.(*CancelTable_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_CheckConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).CheckConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Provider/CheckConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).CheckConfig(ctx, req.(*CheckConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Provider_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilities_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProviderServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Provider/GetCapabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProviderServer).GetCapabilities(ctx, req.(*GetCapabilities_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Provider_ServiceDesc is the grpc.ServiceDesc for Provider service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -337,6 +557,26 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAllTables",
 			Handler:    _Provider_CreateAllTables_Handler,
 		},
+		{
+			MethodName: "CancelPull",
+			Handler:    _Provider_CancelPull_Handler,
+		},
+		{
+			MethodName: "GetPullStatus",
+			Handler:    _Provider_GetPullStatus_Handler,
+		},
+		{
+			MethodName: "CancelTable",
+			Handler:    _Provider_CancelTable_Handler,
+		},
+		{
+			MethodName: "CheckConfig",
+			Handler:    _Provider_CheckConfig_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _Provider_GetCapabilities_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -344,6 +584,11 @@ var Provider_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Provider_PullTables_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Query",
+			Handler:       _Provider_Query_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "grpc/internal/provider.proto",
 }
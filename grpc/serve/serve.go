@@ -31,7 +31,10 @@ func serve(name string, provider shard.ProviderServer) {
 		HandshakeConfig: HandSharkConfig,
 		VersionedPlugins: map[int]plugin.PluginSet{
 			shard.V1: map[string]plugin.Plugin{
-				"provider": &shard.Plugin{Impl: provider},
+				"provider": &shard.Plugin{Impl: provider, ProtocolVersion: shard.V1},
+			},
+			shard.V2: map[string]plugin.Plugin{
+				"provider": &shard.Plugin{Impl: provider, ProtocolVersion: shard.V2},
 			},
 		},
 		GRPCServer: func(opts []grpc.ServerOption) *grpc.Server {
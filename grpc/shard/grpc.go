@@ -5,6 +5,8 @@ import (
 
 	plugin "github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/selefra/selefra-provider-sdk/grpc/internal"
 )
@@ -12,12 +14,21 @@ import (
 const (
 	V1 = 1
 
+	// V2 Add GetCapabilities, the host can know what optional features the provider supports
+	V2 = 2
+
+	// CurrentProtocolVersion The latest protocol version of this SDK
+	CurrentProtocolVersion = V2
+
 	Unmanaged = -1
 )
 
 type GRPCClient struct {
 	broker *plugin.GRPCBroker
 	client internal.ProviderClient
+
+	// The protocol version negotiated with the plugin
+	protocolVersion int
 }
 
 func (g *GRPCClient) GetProviderInformation(ctx context.Context, in *GetProviderInformationRequest) (*GetProviderInformationResponse, error) {
@@ -76,6 +87,67 @@ func (g *GRPCClient) CreateAllTables(ctx context.Context, in *ProviderCreateAllT
 	return ToShardProviderCreateResponse(res), nil
 }
 
+func (g *GRPCClient) CancelPull(ctx context.Context, in *CancelPullRequest) (*CancelPullResponse, error) {
+	res, err := g.client.CancelPull(ctx, ToPbCancelPullRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToShardCancelPullResponse(res), nil
+}
+
+func (g *GRPCClient) GetPullStatus(ctx context.Context, in *GetPullStatusRequest) (*GetPullStatusResponse, error) {
+	res, err := g.client.GetPullStatus(ctx, ToPbGetPullStatusRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToShardGetPullStatusResponse(res), nil
+}
+
+func (g *GRPCClient) CancelTable(ctx context.Context, in *CancelTableRequest) (*CancelTableResponse, error) {
+	res, err := g.client.CancelTable(ctx, ToPbCancelTableRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToShardCancelTableResponse(res), nil
+}
+
+func (g *GRPCClient) CheckConfig(ctx context.Context, in *CheckConfigRequest) (*CheckConfigResponse, error) {
+	res, err := g.client.CheckConfig(ctx, ToPbCheckConfigRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToShardCheckConfigResponse(res), nil
+}
+
+// GetCapabilities What the plugin supports, the plugin speaks protocol version 1 does not have this RPC, so its capabilities are
+// told by V1Capabilities without calling it
+func (g *GRPCClient) GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error) {
+	if g.protocolVersion < V2 {
+		return V1Capabilities(), nil
+	}
+	res, err := g.client.GetCapabilities(ctx, ToPbGetCapabilitiesRequest(in))
+	if status.Code(err) == codes.Unimplemented {
+		return V1Capabilities(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ToShardGetCapabilitiesResponse(res), nil
+}
+
+// ProtocolVersion The protocol version negotiated with the plugin
+func (g *GRPCClient) ProtocolVersion() int {
+	return g.protocolVersion
+}
+
+func (g *GRPCClient) Query(ctx context.Context, in *QueryRequest) (QueryServerStream, error) {
+	res, err := g.client.Query(ctx, ToPbQueryRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return &QueryRecv{in: res}, nil
+}
+
 type Recv struct {
 	in internal.Provider_PullTablesClient
 }
@@ -96,6 +168,26 @@ func (s *Send) Send(p *PullTablesResponse) error {
 	return s.in.Send(ToPbPullTablesResponse(p))
 }
 
+type QueryRecv struct {
+	in internal.Provider_QueryClient
+}
+
+func (t *QueryRecv) Recv() (*QueryResponse, error) {
+	v, err := t.in.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return ToShardQueryResponse(v), nil
+}
+
+type QuerySend struct {
+	in internal.Provider_QueryServer
+}
+
+func (s *QuerySend) Send(p *QueryResponse) error {
+	return s.in.Send(ToPbQueryResponse(p))
+}
+
 type GRPCServer struct {
 	// This is the real implementation
 	Impl ProviderServer
@@ -135,7 +227,8 @@ func (g *GRPCServer) SetProviderConfig(ctx context.Context, in *internal.SetProv
 }
 
 func (g *GRPCServer) PullTables(req *internal.PullTables_Request, send internal.Provider_PullTablesServer) error {
-	return g.Impl.PullTables(context.Background(), ToShardPullTablesRequest(req), &Send{in: send})
+	// The stream context is done when the host cancel the rpc, so the pull can stop with it
+	return g.Impl.PullTables(send.Context(), ToShardPullTablesRequest(req), &Send{in: send})
 }
 
 func (g *GRPCServer) DropTableAll(context.Context, *internal.DropTableAll_Request) (*internal.DropTableAll_Response, error) {
@@ -154,6 +247,50 @@ func (g *GRPCServer) CreateAllTables(context.Context, *internal.CreateAllTables_
 	return &internal.CreateAllTables_Response{Diagnostics: ToPbDiagnostics(v.Diagnostics)}, nil
 }
 
+func (g *GRPCServer) Query(req *internal.Query_Request, send internal.Provider_QueryServer) error {
+	return g.Impl.Query(send.Context(), ToShardQueryRequest(req), &QuerySend{in: send})
+}
+
+func (g *GRPCServer) CancelPull(ctx context.Context, in *internal.CancelPull_Request) (*internal.CancelPull_Response, error) {
+	v, err := g.Impl.CancelPull(ctx, ToShardCancelPullRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToPbCancelPullResponse(v), nil
+}
+
+func (g *GRPCServer) GetPullStatus(ctx context.Context, in *internal.GetPullStatus_Request) (*internal.GetPullStatus_Response, error) {
+	v, err := g.Impl.GetPullStatus(ctx, ToShardGetPullStatusRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToPbGetPullStatusResponse(v), nil
+}
+
+func (g *GRPCServer) CancelTable(ctx context.Context, in *internal.CancelTable_Request) (*internal.CancelTable_Response, error) {
+	v, err := g.Impl.CancelTable(ctx, ToShardCancelTableRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToPbCancelTableResponse(v), nil
+}
+
+func (g *GRPCServer) CheckConfig(ctx context.Context, in *internal.CheckConfig_Request) (*internal.CheckConfig_Response, error) {
+	v, err := g.Impl.CheckConfig(ctx, ToShardCheckConfigRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToPbCheckConfigResponse(v), nil
+}
+
+func (g *GRPCServer) GetCapabilities(ctx context.Context, in *internal.GetCapabilities_Request) (*internal.GetCapabilities_Response, error) {
+	v, err := g.Impl.GetCapabilities(ctx, ToShardGetCapabilitiesRequest(in))
+	if err != nil {
+		return nil, err
+	}
+	return ToPbGetCapabilitiesResponse(v), nil
+}
+
 // Plugin This is the implementation of plugin.GRPCServer so we can serve/consume this.
 type Plugin struct {
 	// GRPCPlugin must still implement the Stub interface
@@ -161,6 +298,8 @@ type Plugin struct {
 	// Concrete implementation, written in Go. This is only used for plugins
 	// that are written in Go.
 	Impl ProviderServer
+	// Which protocol version this plugin set is for, 0 is V1
+	ProtocolVersion int
 }
 
 func (p *Plugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
@@ -169,5 +308,9 @@ func (p *Plugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
 }
 
 func (p *Plugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (any, error) {
-	return &GRPCClient{broker: broker, client: internal.NewProviderClient(c)}, nil
+	protocolVersion := p.ProtocolVersion
+	if protocolVersion == 0 {
+		protocolVersion = V1
+	}
+	return &GRPCClient{broker: broker, client: internal.NewProviderClient(c), protocolVersion: protocolVersion}, nil
 }
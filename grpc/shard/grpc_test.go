@@ -0,0 +1,46 @@
+package shard
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/internal"
+)
+
+// A plugin built before GetCapabilities
+type testUnimplementedProviderClient struct {
+	internal.ProviderClient
+}
+
+func (x *testUnimplementedProviderClient) GetCapabilities(ctx context.Context, in *internal.GetCapabilities_Request, opts ...grpc.CallOption) (*internal.GetCapabilities_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+
+func TestGRPCClient_GetCapabilities(t *testing.T) {
+
+	// negotiated V1, the RPC is not called
+	client := &GRPCClient{protocolVersion: V1}
+	capabilities, err := client.GetCapabilities(context.Background(), &GetCapabilitiesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, V1, capabilities.ProtocolVersion)
+	assert.False(t, capabilities.HasFeature(FeatureCheckConfig))
+	assert.Equal(t, []StorageType{POSTGRESQL}, capabilities.StorageTypes)
+
+	// negotiated V2, but the plugin does not implement it
+	client = &GRPCClient{protocolVersion: V2, client: &testUnimplementedProviderClient{}}
+	capabilities, err = client.GetCapabilities(context.Background(), &GetCapabilitiesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, V1, capabilities.ProtocolVersion)
+
+	// the plugin set of each version tells the client which version is negotiated
+	for version, pluginSet := range VersionPluginMap {
+		raw, err := pluginSet["provider"].(*Plugin).GRPCClient(context.Background(), nil, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, version, raw.(*GRPCClient).ProtocolVersion())
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	plugin "github.com/hashicorp/go-plugin"
 	"github.com/selefra/selefra-utils/pkg/json_util"
@@ -16,21 +17,28 @@ import (
 
 var (
 	PluginMap = map[string]plugin.Plugin{
-		"provider": &Plugin{},
+		"provider": &Plugin{ProtocolVersion: V1},
 	}
+	PluginMapV2 = map[string]plugin.Plugin{
+		"provider": &Plugin{ProtocolVersion: V2},
+	}
+	// VersionPluginMap The host negotiates the highest version both sides speak, so the old plugins still work
 	VersionPluginMap = map[int]plugin.PluginSet{
 		V1: PluginMap,
+		V2: PluginMapV2,
 	}
 )
 
 type ProviderClient interface {
 	Provider
 	PullTables(ctx context.Context, in *PullTablesRequest) (ProviderServerStream, error)
+	Query(ctx context.Context, in *QueryRequest) (QueryServerStream, error)
 }
 
 type ProviderServer interface {
 	Provider
 	PullTables(context.Context, *PullTablesRequest, ProviderServerSender) error
+	Query(context.Context, *QueryRequest, QueryServerSender) error
 }
 
 type Provider interface {
@@ -40,6 +48,11 @@ type Provider interface {
 	SetProviderConfig(ctx context.Context, in *SetProviderConfigRequest) (*SetProviderConfigResponse, error)
 	DropTableAll(ctx context.Context, in *ProviderDropTableAllRequest) (*ProviderDropTableAllResponse, error)
 	CreateAllTables(ctx context.Context, in *ProviderCreateAllTablesRequest) (*ProviderCreateAllTablesResponse, error)
+	CancelPull(ctx context.Context, in *CancelPullRequest) (*CancelPullResponse, error)
+	GetPullStatus(ctx context.Context, in *GetPullStatusRequest) (*GetPullStatusResponse, error)
+	CancelTable(ctx context.Context, in *CancelTableRequest) (*CancelTableResponse, error)
+	CheckConfig(ctx context.Context, in *CheckConfigRequest) (*CheckConfigResponse, error)
+	GetCapabilities(ctx context.Context, in *GetCapabilitiesRequest) (*GetCapabilitiesResponse, error)
 }
 
 // -------------------------------------------------------------------------------------------------------------------------
@@ -124,6 +137,71 @@ type GetProviderConfigResponse struct {
 	Diagnostics *schema.Diagnostics `json:"diagnostics"`
 }
 
+// ------------------------------------------------- CheckConfig -------------------------------------------------------
+
+// CheckConfigRequest Check a provider config without using it, nothing of the provider is changed
+type CheckConfigRequest struct {
+	Config string `json:"config"`
+
+	// Also check the credentials in the config can access the API, it sends requests to the API
+	IsProbeCredentials bool `json:"is_probe_credentials"`
+}
+
+type CheckConfigResponse struct {
+	Diagnostics *schema.Diagnostics `json:"diagnostics"`
+}
+
+// ------------------------------------------------- GetCapabilities ---------------------------------------------------
+
+// The optional features a provider may support, the host checks them before using the feature
+const (
+	FeatureCheckConfig      = "check_config"
+	FeatureCheckCredentials = "check_credentials"
+	FeatureCancelPull       = "cancel_pull"
+	FeatureCancelTable      = "cancel_table"
+	FeaturePullStatus       = "pull_status"
+	FeatureQuery            = "query"
+	FeatureDryRun           = "dry_run"
+	FeatureCheckpoint       = "checkpoint"
+	FeatureIncremental      = "incremental"
+	FeatureTableParameters  = "table_parameters"
+	FeatureSampling         = "sampling"
+)
+
+type GetCapabilitiesRequest struct{}
+
+type GetCapabilitiesResponse struct {
+
+	// The latest protocol version the provider speaks
+	ProtocolVersion int `json:"protocol_version"`
+
+	Features []string `json:"features"`
+
+	StorageTypes []StorageType `json:"storage_types"`
+
+	Diagnostics *schema.Diagnostics `json:"diagnostics"`
+}
+
+// HasFeature Whether the provider supports the feature
+func (x *GetCapabilitiesResponse) HasFeature(feature string) bool {
+	for _, f := range x.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// V1Capabilities The capabilities of the plugin speaks protocol version 1, it can not tell its optional features,
+// so none of them is assumed, the storage is always postgresql
+func V1Capabilities() *GetCapabilitiesResponse {
+	return &GetCapabilitiesResponse{
+		ProtocolVersion: V1,
+		Features:        []string{},
+		StorageTypes:    []StorageType{POSTGRESQL},
+	}
+}
+
 // ------------------------------------------------- SetProviderConfig -------------------------------------------------
 
 type SetProviderConfigRequest struct {
@@ -139,14 +217,55 @@ type SetProviderConfigResponse struct {
 
 type PullTablesRequest struct {
 
-	// The table to be pulled, this later expands to support parameters
+	// The table to be pulled, each one is an exact table name, a glob pattern such as "*" or "aws_ec2_*",
+	// or a regular expression with the prefix "regex:", a sub table is pulled with the ancestors it needs,
+	// a selected table is pulled with all its sub tables, the parameters of the tables are in TableParameters
 	Tables []string `json:"tables"`
 
+	// The tables matching these patterns are not pulled, and neither are their sub tables, the same patterns as Tables
+	ExcludeTables []string `json:"exclude_tables"`
+
+	// Also pull the tables with any of these tags, the tags are declared on schema.Table, for example security, network
+	Tags []string `json:"tags"`
+
+	// The tables with any of these tags are not pulled, and neither are their sub tables, for example expensive
+	ExcludeTags []string `json:"exclude_tags"`
+
 	// Maximum number of threads used
 	MaxGoroutines uint64 `json:"max_goroutines"`
 
-	// Pull timeout period
+	// Pull timeout period, in milliseconds, less than or equal to 0 means no timeout
 	Timeout int64 `json:"timeout"`
+
+	// The memory limit of the pull, in MB, when the memory usage exceeds it, no more data source pull is started until the memory falls back,
+	// the pull starts anyway with a warning if no other pull is running or the wait times out, 0 means use the provider's setting
+	MaxMemoryMB uint64 `json:"max_memory_mb"`
+
+	// Resume from the checkpoint of the last interrupted pull of the same tables, the completed root tables and client expansions are skipped,
+	// if false, the pull starts from scratch. The progress of the pull is saved when it is set
+	Resume bool `json:"resume"`
+
+	// Save the progress of the pull to the storage, so that it can be resumed by a later pull with Resume if interrupted,
+	// the progress is not saved if neither this nor Resume is set, the stale checkpoint is still cleared when the pull succeeds
+	Checkpoint bool `json:"checkpoint"`
+
+	// Ignore the watermarks saved by the last pulls, the incremental tables are pulled in full, the watermarks are saved again after the pull
+	FullResync bool `json:"full_resync"`
+
+	// Run the extraction and transformation only, the rows are sent to the provider's dry-run sink instead of the storage,
+	// the storage is not required, and the checkpoint and watermarks are neither read nor saved
+	DryRun bool `json:"dry_run"`
+
+	// Sampling mode for the fast smoke runs, at most how many raw results of each table are pulled, the pull of a table is stopped
+	// once it has enough results, 0 means no limit
+	SampleResultLimit uint64 `json:"sample_result_limit"`
+
+	// Sampling mode for the fast smoke runs, at most how many child tasks of each sub table are started by a parent task, 0 means no limit
+	SampleChildTaskLimit uint64 `json:"sample_child_task_limit"`
+
+	// <tableName, <parameterName, value>>, the parameters passed to the Pull of the tables for a targeted refresh, for example a region list,
+	// resource ids or a time window, they are checked by the schema.TableParameter declared on the tables before the pull starts
+	TableParameters map[string]map[string]any `json:"table_parameters"`
 }
 
 // NewPullAllTablesRequest The Provider integration test simulates the RPC environment
@@ -163,6 +282,103 @@ type PullTablesResponse struct {
 	TableCount     uint64              `json:"table_count"`
 	Table          string              `json:"table"`
 	Diagnostics    *schema.Diagnostics `json:"diagnostic"`
+
+	// The statistics of the tables, <tableName, statistics>, the progress message of a finished table carries the statistics of that table,
+	// and the last message of the pull carries the summary of all tables
+	TableStatistics map[string]*schema.PullTableStatistics `json:"table_statistics"`
+
+	// The id of this pull, the first message is sent as soon as the pull starts, so the host can check or cancel it at once
+	PullId string `json:"pull_id"`
+}
+
+// ------------------------------------------------- Pull Control ------------------------------------------------------
+
+// CancelPullRequest Cancel a running pull, the pull stops as if the host cancelled the rpc
+type CancelPullRequest struct {
+	PullId string `json:"pull_id"`
+}
+
+type CancelPullResponse struct {
+	Diagnostics *schema.Diagnostics `json:"diagnostics"`
+}
+
+// CancelTableRequest Cancel a root table of a running pull, the other tables keep running
+type CancelTableRequest struct {
+	PullId string `json:"pull_id"`
+
+	// Only the root table can be cancelled, its sub tables are cancelled with it
+	Table string `json:"table"`
+}
+
+type CancelTableResponse struct {
+	Diagnostics *schema.Diagnostics `json:"diagnostics"`
+}
+
+type GetPullStatusRequest struct {
+	PullId string `json:"pull_id"`
+}
+
+// GetPullStatusResponse The progress of a running pull
+type GetPullStatusResponse struct {
+	PullId string `json:"pull_id"`
+
+	// How long the pull has been running
+	ElapsedTime time.Duration `json:"elapsed_time"`
+
+	// The tasks waiting in the queue
+	QueuedTaskCount uint64 `json:"queued_task_count"`
+
+	// The tasks submitted but not done, include the queued and running tasks
+	UnfinishedTaskCount uint64 `json:"unfinished_task_count"`
+
+	// <tableName, running task count>
+	RunningTaskCount map[string]uint64 `json:"running_task_count"`
+
+	FinishedTables map[string]bool `json:"finished_tables"`
+	TableCount     uint64          `json:"table_count"`
+
+	// The root tables cancelled by CancelTable
+	CancelledTables []string `json:"cancelled_tables"`
+
+	Diagnostics *schema.Diagnostics `json:"diagnostics"`
+}
+
+// ------------------------------------------------- Query -------------------------------------------------------------
+
+// QueryRequest Run a read-only query on the provider's storage
+type QueryRequest struct {
+
+	// Only one SELECT statement is allowed
+	Query string `json:"query"`
+
+	// Max rows returned, the rest is truncated, 0 means use the default limit
+	RowLimit uint64 `json:"row_limit"`
+
+	// Statement timeout, in milliseconds, 0 means use the default timeout
+	Timeout int64 `json:"timeout"`
+}
+
+// QueryResponse The query result is streamed back in batches
+type QueryResponse struct {
+
+	// Only the first response carry the column names
+	ColumnNames []string `json:"column_names"`
+
+	// The value of a cell can be nil, bool, int64, float64, string, []byte, time.Time, or the json decoded value of a complex value
+	Rows [][]any `json:"rows"`
+
+	// The last response tell whether rows is truncated by row limit
+	IsTruncated bool `json:"is_truncated"`
+
+	Diagnostics *schema.Diagnostics `json:"diagnostics"`
+}
+
+type QueryServerStream interface {
+	Recv() (*QueryResponse, error)
+}
+
+type QueryServerSender interface {
+	Send(*QueryResponse) error
 }
 
 //
@@ -264,6 +480,16 @@ func (x *Storage) GetStorageOptions() storage.CreateStorageOptions {
 	}
 }
 
+// ToShardStorageType The storage type of the protocol, false if it is not in the protocol
+func ToShardStorageType(storageType storage_factory.StorageType) (StorageType, bool) {
+	switch storageType {
+	case storage_factory.StorageTypePostgresql:
+		return POSTGRESQL, true
+	default:
+		return 0, false
+	}
+}
+
 type StorageType int
 
 const (
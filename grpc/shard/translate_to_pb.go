@@ -1,6 +1,14 @@
 package shard
 
 import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/reflect_util"
+	"github.com/spf13/cast"
+
 	"github.com/selefra/selefra-provider-sdk/grpc/internal"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
 )
@@ -80,6 +88,7 @@ func ToPbSchemaTable(table *schema.Table) *internal.Table {
 		Columns:     ToPbSchemaColumns(table.Columns),
 		SubTables:   ToPbSchemaTablesByList(table.SubTables),
 		Version:     table.Version,
+		Tags:        table.Tags,
 	}
 }
 
@@ -143,6 +152,43 @@ func ToPbSetProviderConfigResponse(in *SetProviderConfigResponse) *internal.SetP
 	}
 }
 
+func ToPbCheckConfigRequest(in *CheckConfigRequest) *internal.CheckConfig_Request {
+	if in == nil {
+		return nil
+	}
+	return &internal.CheckConfig_Request{Config: in.Config, ProbeCredentials: in.IsProbeCredentials}
+}
+
+func ToPbCheckConfigResponse(in *CheckConfigResponse) *internal.CheckConfig_Response {
+	if in == nil {
+		return nil
+	}
+	return &internal.CheckConfig_Response{Diagnostics: ToPbDiagnostics(in.Diagnostics)}
+}
+
+func ToPbGetCapabilitiesRequest(in *GetCapabilitiesRequest) *internal.GetCapabilities_Request {
+	if in == nil {
+		return nil
+	}
+	return &internal.GetCapabilities_Request{}
+}
+
+func ToPbGetCapabilitiesResponse(in *GetCapabilitiesResponse) *internal.GetCapabilities_Response {
+	if in == nil {
+		return nil
+	}
+	storageTypes := make([]internal.StorageType, 0, len(in.StorageTypes))
+	for _, storageType := range in.StorageTypes {
+		storageTypes = append(storageTypes, internal.StorageType(storageType))
+	}
+	return &internal.GetCapabilities_Response{
+		ProtocolVersion: int64(in.ProtocolVersion),
+		Features:        in.Features,
+		StorageTypes:    storageTypes,
+		Diagnostics:     ToPbDiagnostics(in.Diagnostics),
+	}
+}
+
 func ToPbStorage(storage *Storage) *internal.Storage {
 	if storage == nil {
 		return nil
@@ -164,7 +210,36 @@ func ToPbPullTablesRequest(in *PullTablesRequest) *internal.PullTables_Request {
 		Tables:        in.Tables,
 		MaxGoroutines: in.MaxGoroutines,
 		Timeout:       in.Timeout,
+		MaxMemoryMb:   in.MaxMemoryMB,
+		Resume:        in.Resume,
+		Checkpoint:    in.Checkpoint,
+		FullResync:    in.FullResync,
+		DryRun:        in.DryRun,
+
+		SampleResultLimit:    in.SampleResultLimit,
+		SampleChildTaskLimit: in.SampleChildTaskLimit,
+
+		TableParameters: ToPbTableParameters(in.TableParameters),
+
+		ExcludeTables: in.ExcludeTables,
+		Tags:          in.Tags,
+		ExcludeTags:   in.ExcludeTags,
+	}
+}
+
+func ToPbTableParameters(in map[string]map[string]any) map[string]*internal.TableParameters {
+	if len(in) == 0 {
+		return nil
 	}
+	tableParameters := make(map[string]*internal.TableParameters, len(in))
+	for tableName, parameters := range in {
+		values := make(map[string]*internal.QueryValue, len(parameters))
+		for name, value := range parameters {
+			values[name] = ToPbQueryValue(value)
+		}
+		tableParameters[tableName] = &internal.TableParameters{Values: values}
+	}
+	return tableParameters
 }
 
 func ToPbPullTablesResponse(in *PullTablesResponse) *internal.PullTables_Response {
@@ -177,6 +252,55 @@ func ToPbPullTablesResponse(in *PullTablesResponse) *internal.PullTables_Respons
 		TableCount:     in.TableCount,
 		Table:          in.Table,
 		Diagnostics:    ToPbDiagnostics(in.Diagnostics),
+
+		TableStatistics: ToPbPullTableStatisticsMap(in.TableStatistics),
+		PullId:          in.PullId,
+	}
+}
+
+func ToPbPullTableStatisticsMap(in map[string]*schema.PullTableStatistics) map[string]*internal.PullTableStatistics {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make(map[string]*internal.PullTableStatistics, len(in))
+	for tableName, tableStatistics := range in {
+		if tableStatistics == nil {
+			continue
+		}
+		clientStatisticsMap := make(map[string]*internal.PullClientStatistics, len(tableStatistics.ClientStatistics))
+		for clientKey, clientStatistics := range tableStatistics.ClientStatistics {
+			clientStatisticsMap[clientKey] = &internal.PullClientStatistics{
+				Counters:      ToPbPullCounters(&clientStatistics.PullCounters),
+				ClientKey:     clientStatistics.ClientKey,
+				ClientLabels:  clientStatistics.ClientLabels,
+				IsCircuitOpen: clientStatistics.IsCircuitOpen,
+			}
+		}
+		result[tableName] = &internal.PullTableStatistics{
+			Counters:         ToPbPullCounters(&tableStatistics.PullCounters),
+			TableName:        tableStatistics.TableName,
+			ThrottleWaitTime: tableStatistics.ThrottleWaitTime.Milliseconds(),
+			IsCutOff:         tableStatistics.IsCutOff,
+			ClientStatistics: clientStatisticsMap,
+		}
+	}
+	return result
+}
+
+func ToPbPullCounters(in *schema.PullCounters) *internal.PullCounters {
+	return &internal.PullCounters{
+		TaskCount:          in.TaskCount,
+		RawResultCount:     in.RawResultCount,
+		DroppedResultCount: in.DroppedResultCount,
+		RowCount:           in.RowCount,
+		ErrorCount:         in.ErrorCount,
+		IgnoredErrorCount:  in.IgnoredErrorCount,
+		RetryCount:         in.RetryCount,
+		Duration:           in.Duration.Milliseconds(),
+		SkippedTaskCount:   in.SkippedTaskCount,
+
+		ResultChannelBlockedTime: in.ResultChannelBlockedTime.Milliseconds(),
+		DuplicateRowCount:        in.DuplicateRowCount,
 	}
 }
 
@@ -189,6 +313,8 @@ func ToPbDiagnostics(diagnostics *schema.Diagnostics) []*internal.Diagnostic {
 		result[index] = &internal.Diagnostic{
 			DiagnosticLevel: internal.Diagnostic_DiagnosticLevel(diagnostic.Level()),
 			Content:         diagnostic.Content(),
+			ClientKey:       diagnostic.ClientKey(),
+			ClientLabels:    diagnostic.ClientLabels(),
 		}
 	}
 	return result
@@ -203,3 +329,186 @@ func ToPbDropTableRequest(in *ProviderDropTableAllRequest) *internal.DropTableAl
 func ToPbCreateTableRequest(in *ProviderCreateAllTablesRequest) *internal.CreateAllTables_Request {
 	return &internal.CreateAllTables_Request{}
 }
+
+// ------------------------------------------------- Query -------------------------------------------------------------
+
+func ToPbQueryRequest(in *QueryRequest) *internal.Query_Request {
+	if in == nil {
+		return nil
+	}
+	return &internal.Query_Request{
+		Query:    in.Query,
+		RowLimit: in.RowLimit,
+		Timeout:  in.Timeout,
+	}
+}
+
+func ToPbQueryResponse(in *QueryResponse) *internal.Query_Response {
+	if in == nil {
+		return nil
+	}
+	return &internal.Query_Response{
+		ColumnNames: in.ColumnNames,
+		Rows:        ToPbQueryRows(in.Rows),
+		IsTruncated: in.IsTruncated,
+		Diagnostics: ToPbDiagnostics(in.Diagnostics),
+	}
+}
+
+func ToPbQueryRows(rows [][]any) []*internal.QueryRow {
+	if len(rows) == 0 {
+		return nil
+	}
+	result := make([]*internal.QueryRow, len(rows))
+	for rowIndex, row := range rows {
+		values := make([]*internal.QueryValue, len(row))
+		for columnIndex, value := range row {
+			values[columnIndex] = ToPbQueryValue(value)
+		}
+		result[rowIndex] = &internal.QueryRow{Values: values}
+	}
+	return result
+}
+
+// Some storage driver types can assign itself to go types, for example pgtype arrays
+type queryValueAssignable interface {
+	AssignTo(dst any) error
+}
+
+// ToPbQueryValue The value queried from storage may be a driver specific type, convert it to a typed value as far as possible,
+// and fallback to json if it is a complex value
+func ToPbQueryValue(value any) *internal.QueryValue {
+
+	if reflect_util.IsNil(value) {
+		return &internal.QueryValue{Value: &internal.QueryValue_IsNull{IsNull: true}}
+	}
+
+	switch v := value.(type) {
+	case bool:
+		return &internal.QueryValue{Value: &internal.QueryValue_BoolValue{BoolValue: v}}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return &internal.QueryValue{Value: &internal.QueryValue_IntValue{IntValue: cast.ToInt64(v)}}
+	case float32, float64:
+		return &internal.QueryValue{Value: &internal.QueryValue_FloatValue{FloatValue: cast.ToFloat64(v)}}
+	case string:
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: v}}
+	case []byte:
+		return &internal.QueryValue{Value: &internal.QueryValue_BytesValue{BytesValue: v}}
+	case time.Time:
+		return &internal.QueryValue{Value: &internal.QueryValue_TimestampValue{TimestampValue: v.UnixNano()}}
+	case net.IPNet:
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: v.String()}}
+	case *net.IPNet:
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: v.String()}}
+	case net.IP:
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: v.String()}}
+	case net.HardwareAddr:
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: v.String()}}
+	case queryValueAssignable:
+		if slice, ok := assignToSlice(v); ok {
+			return toPbJsonQueryValue(slice)
+		}
+	case fmt.Stringer:
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: v.String()}}
+	}
+
+	return toPbJsonQueryValue(value)
+}
+
+func assignToSlice(value queryValueAssignable) (any, bool) {
+	var stringSlice []string
+	if err := value.AssignTo(&stringSlice); err == nil {
+		return stringSlice, true
+	}
+	var intSlice []int64
+	if err := value.AssignTo(&intSlice); err == nil {
+		return intSlice, true
+	}
+	var floatSlice []float64
+	if err := value.AssignTo(&floatSlice); err == nil {
+		return floatSlice, true
+	}
+	var boolSlice []bool
+	if err := value.AssignTo(&boolSlice); err == nil {
+		return boolSlice, true
+	}
+	var ipNetSlice []*net.IPNet
+	if err := value.AssignTo(&ipNetSlice); err == nil {
+		ipSlice := make([]string, 0, len(ipNetSlice))
+		for _, ipNet := range ipNetSlice {
+			ipSlice = append(ipSlice, ipNet.String())
+		}
+		return ipSlice, true
+	}
+	var macSlice []net.HardwareAddr
+	if err := value.AssignTo(&macSlice); err == nil {
+		addressSlice := make([]string, 0, len(macSlice))
+		for _, mac := range macSlice {
+			addressSlice = append(addressSlice, mac.String())
+		}
+		return addressSlice, true
+	}
+	return nil, false
+}
+
+func toPbJsonQueryValue(value any) *internal.QueryValue {
+	marshal, err := json.Marshal(value)
+	if err != nil {
+		return &internal.QueryValue{Value: &internal.QueryValue_StringValue{StringValue: fmt.Sprintf("%v", value)}}
+	}
+	return &internal.QueryValue{Value: &internal.QueryValue_JsonValue{JsonValue: string(marshal)}}
+}
+
+// ------------------------------------------------- Pull Control ------------------------------------------------------
+
+func ToPbCancelPullRequest(in *CancelPullRequest) *internal.CancelPull_Request {
+	if in == nil {
+		return nil
+	}
+	return &internal.CancelPull_Request{PullId: in.PullId}
+}
+
+func ToPbCancelPullResponse(in *CancelPullResponse) *internal.CancelPull_Response {
+	if in == nil {
+		return nil
+	}
+	return &internal.CancelPull_Response{Diagnostics: ToPbDiagnostics(in.Diagnostics)}
+}
+
+func ToPbCancelTableRequest(in *CancelTableRequest) *internal.CancelTable_Request {
+	if in == nil {
+		return nil
+	}
+	return &internal.CancelTable_Request{PullId: in.PullId, Table: in.Table}
+}
+
+func ToPbCancelTableResponse(in *CancelTableResponse) *internal.CancelTable_Response {
+	if in == nil {
+		return nil
+	}
+	return &internal.CancelTable_Response{Diagnostics: ToPbDiagnostics(in.Diagnostics)}
+}
+
+func ToPbGetPullStatusRequest(in *GetPullStatusRequest) *internal.GetPullStatus_Request {
+	if in == nil {
+		return nil
+	}
+	return &internal.GetPullStatus_Request{PullId: in.PullId}
+}
+
+func ToPbGetPullStatusResponse(in *GetPullStatusResponse) *internal.GetPullStatus_Response {
+	if in == nil {
+		return nil
+	}
+	return &internal.GetPullStatus_Response{
+		PullId:              in.PullId,
+		ElapsedTime:         in.ElapsedTime.Milliseconds(),
+		QueuedTaskCount:     in.QueuedTaskCount,
+		UnfinishedTaskCount: in.UnfinishedTaskCount,
+		RunningTaskCount:    in.RunningTaskCount,
+		FinishedTables:      in.FinishedTables,
+		TableCount:          in.TableCount,
+		CancelledTables:     in.CancelledTables,
+		Diagnostics:         ToPbDiagnostics(in.Diagnostics),
+	}
+}
@@ -1,6 +1,9 @@
 package shard
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/selefra/selefra-provider-sdk/grpc/internal"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
 )
@@ -76,6 +79,7 @@ func ToSchemaTable(table *internal.Table) *schema.Table {
 		Columns:     ToSchemaColumns(table.GetColumns()),
 		SubTables:   ToSchemaTablesByList(table.GetSubTables()),
 		Version:     table.GetVersion(),
+		Tags:        table.GetTags(),
 	}
 	schemaTable.Runtime().Namespace = table.GetNamespace()
 	return schemaTable
@@ -151,9 +155,38 @@ func ToShardPullTablesRequest(in *internal.PullTables_Request) *PullTablesReques
 		Tables:        in.GetTables(),
 		MaxGoroutines: in.GetMaxGoroutines(),
 		Timeout:       in.GetTimeout(),
+		MaxMemoryMB:   in.GetMaxMemoryMb(),
+		Resume:        in.GetResume(),
+		Checkpoint:    in.GetCheckpoint(),
+		FullResync:    in.GetFullResync(),
+		DryRun:        in.GetDryRun(),
+
+		SampleResultLimit:    in.GetSampleResultLimit(),
+		SampleChildTaskLimit: in.GetSampleChildTaskLimit(),
+
+		TableParameters: ToShardTableParameters(in.GetTableParameters()),
+
+		ExcludeTables: in.GetExcludeTables(),
+		Tags:          in.GetTags(),
+		ExcludeTags:   in.GetExcludeTags(),
 	}
 }
 
+func ToShardTableParameters(in map[string]*internal.TableParameters) map[string]map[string]any {
+	if len(in) == 0 {
+		return nil
+	}
+	tableParameters := make(map[string]map[string]any, len(in))
+	for tableName, parameters := range in {
+		values := make(map[string]any, len(parameters.GetValues()))
+		for name, value := range parameters.GetValues() {
+			values[name] = ToShardQueryValue(value)
+		}
+		tableParameters[tableName] = values
+	}
+	return tableParameters
+}
+
 func ToShardSetProviderConfigResponse(in *internal.SetProviderConfig_Response) *SetProviderConfigResponse {
 	if in == nil {
 		return nil
@@ -174,6 +207,52 @@ func ToShardPullTablesResponse(in *internal.PullTables_Response) *PullTablesResp
 		TableCount:     in.GetTableCount(),
 		Table:          in.Table,
 		Diagnostics:    ToShardDiagnostics(in.Diagnostics),
+
+		TableStatistics: ToShardPullTableStatisticsMap(in.GetTableStatistics()),
+		PullId:          in.GetPullId(),
+	}
+}
+
+func ToShardPullTableStatisticsMap(in map[string]*internal.PullTableStatistics) map[string]*schema.PullTableStatistics {
+	if len(in) == 0 {
+		return nil
+	}
+	result := make(map[string]*schema.PullTableStatistics, len(in))
+	for tableName, tableStatistics := range in {
+		clientStatisticsMap := make(map[string]*schema.PullClientStatistics, len(tableStatistics.GetClientStatistics()))
+		for clientKey, clientStatistics := range tableStatistics.GetClientStatistics() {
+			clientStatisticsMap[clientKey] = &schema.PullClientStatistics{
+				PullCounters:  ToShardPullCounters(clientStatistics.GetCounters()),
+				ClientKey:     clientStatistics.GetClientKey(),
+				ClientLabels:  clientStatistics.GetClientLabels(),
+				IsCircuitOpen: clientStatistics.GetIsCircuitOpen(),
+			}
+		}
+		result[tableName] = &schema.PullTableStatistics{
+			PullCounters:     ToShardPullCounters(tableStatistics.GetCounters()),
+			TableName:        tableStatistics.GetTableName(),
+			ThrottleWaitTime: time.Duration(tableStatistics.GetThrottleWaitTime()) * time.Millisecond,
+			IsCutOff:         tableStatistics.GetIsCutOff(),
+			ClientStatistics: clientStatisticsMap,
+		}
+	}
+	return result
+}
+
+func ToShardPullCounters(in *internal.PullCounters) schema.PullCounters {
+	return schema.PullCounters{
+		TaskCount:          in.GetTaskCount(),
+		RawResultCount:     in.GetRawResultCount(),
+		DroppedResultCount: in.GetDroppedResultCount(),
+		RowCount:           in.GetRowCount(),
+		ErrorCount:         in.GetErrorCount(),
+		IgnoredErrorCount:  in.GetIgnoredErrorCount(),
+		RetryCount:         in.GetRetryCount(),
+		Duration:           time.Duration(in.GetDuration()) * time.Millisecond,
+		SkippedTaskCount:   in.GetSkippedTaskCount(),
+
+		ResultChannelBlockedTime: time.Duration(in.GetResultChannelBlockedTime()) * time.Millisecond,
+		DuplicateRowCount:        in.GetDuplicateRowCount(),
 	}
 }
 
@@ -183,7 +262,7 @@ func ToShardDiagnostics(pbDiagnosticSlice []*internal.Diagnostic) *schema.Diagno
 		return result
 	}
 	for _, diagnostic := range pbDiagnosticSlice {
-		result.Add(schema.NewDiagnostic(schema.DiagnosticLevel(diagnostic.GetDiagnosticLevel()), diagnostic.GetContent()))
+		result.Add(schema.NewDiagnostic(schema.DiagnosticLevel(diagnostic.GetDiagnosticLevel()), diagnostic.GetContent()).WithClient(diagnostic.GetClientKey(), diagnostic.GetClientLabels()))
 	}
 	return result
 }
@@ -207,3 +286,162 @@ func ToShardProviderCreateResponse(response *internal.CreateAllTables_Response)
 		Diagnostics: ToShardDiagnostics(response.Diagnostics),
 	}
 }
+
+// ------------------------------------------------- Query -------------------------------------------------------------
+
+func ToShardQueryRequest(in *internal.Query_Request) *QueryRequest {
+	if in == nil {
+		return nil
+	}
+	return &QueryRequest{
+		Query:    in.GetQuery(),
+		RowLimit: in.GetRowLimit(),
+		Timeout:  in.GetTimeout(),
+	}
+}
+
+func ToShardQueryResponse(in *internal.Query_Response) *QueryResponse {
+	if in == nil {
+		return nil
+	}
+	return &QueryResponse{
+		ColumnNames: in.GetColumnNames(),
+		Rows:        ToShardQueryRows(in.GetRows()),
+		IsTruncated: in.GetIsTruncated(),
+		Diagnostics: ToShardDiagnostics(in.GetDiagnostics()),
+	}
+}
+
+func ToShardQueryRows(rows []*internal.QueryRow) [][]any {
+	if len(rows) == 0 {
+		return nil
+	}
+	result := make([][]any, len(rows))
+	for rowIndex, row := range rows {
+		values := make([]any, len(row.GetValues()))
+		for columnIndex, value := range row.GetValues() {
+			values[columnIndex] = ToShardQueryValue(value)
+		}
+		result[rowIndex] = values
+	}
+	return result
+}
+
+func ToShardQueryValue(value *internal.QueryValue) any {
+	if value == nil {
+		return nil
+	}
+	switch v := value.GetValue().(type) {
+	case *internal.QueryValue_BoolValue:
+		return v.BoolValue
+	case *internal.QueryValue_IntValue:
+		return v.IntValue
+	case *internal.QueryValue_FloatValue:
+		return v.FloatValue
+	case *internal.QueryValue_StringValue:
+		return v.StringValue
+	case *internal.QueryValue_BytesValue:
+		return v.BytesValue
+	case *internal.QueryValue_TimestampValue:
+		return time.Unix(0, v.TimestampValue)
+	case *internal.QueryValue_JsonValue:
+		var jsonValue any
+		if err := json.Unmarshal([]byte(v.JsonValue), &jsonValue); err != nil {
+			return v.JsonValue
+		}
+		return jsonValue
+	default:
+		return nil
+	}
+}
+
+// ------------------------------------------------- Pull Control ------------------------------------------------------
+
+func ToShardCancelPullRequest(in *internal.CancelPull_Request) *CancelPullRequest {
+	if in == nil {
+		return nil
+	}
+	return &CancelPullRequest{PullId: in.GetPullId()}
+}
+
+func ToShardCancelPullResponse(in *internal.CancelPull_Response) *CancelPullResponse {
+	if in == nil {
+		return nil
+	}
+	return &CancelPullResponse{Diagnostics: ToShardDiagnostics(in.GetDiagnostics())}
+}
+
+func ToShardCheckConfigRequest(in *internal.CheckConfig_Request) *CheckConfigRequest {
+	if in == nil {
+		return nil
+	}
+	return &CheckConfigRequest{Config: in.GetConfig(), IsProbeCredentials: in.GetProbeCredentials()}
+}
+
+func ToShardCheckConfigResponse(in *internal.CheckConfig_Response) *CheckConfigResponse {
+	if in == nil {
+		return nil
+	}
+	return &CheckConfigResponse{Diagnostics: ToShardDiagnostics(in.GetDiagnostics())}
+}
+
+func ToShardGetCapabilitiesRequest(in *internal.GetCapabilities_Request) *GetCapabilitiesRequest {
+	if in == nil {
+		return nil
+	}
+	return &GetCapabilitiesRequest{}
+}
+
+func ToShardGetCapabilitiesResponse(in *internal.GetCapabilities_Response) *GetCapabilitiesResponse {
+	if in == nil {
+		return nil
+	}
+	storageTypes := make([]StorageType, 0, len(in.GetStorageTypes()))
+	for _, storageType := range in.GetStorageTypes() {
+		storageTypes = append(storageTypes, StorageType(storageType))
+	}
+	return &GetCapabilitiesResponse{
+		ProtocolVersion: int(in.GetProtocolVersion()),
+		Features:        in.GetFeatures(),
+		StorageTypes:    storageTypes,
+		Diagnostics:     ToShardDiagnostics(in.GetDiagnostics()),
+	}
+}
+
+func ToShardCancelTableRequest(in *internal.CancelTable_Request) *CancelTableRequest {
+	if in == nil {
+		return nil
+	}
+	return &CancelTableRequest{PullId: in.GetPullId(), Table: in.GetTable()}
+}
+
+func ToShardCancelTableResponse(in *internal.CancelTable_Response) *CancelTableResponse {
+	if in == nil {
+		return nil
+	}
+	return &CancelTableResponse{Diagnostics: ToShardDiagnostics(in.GetDiagnostics())}
+}
+
+func ToShardGetPullStatusRequest(in *internal.GetPullStatus_Request) *GetPullStatusRequest {
+	if in == nil {
+		return nil
+	}
+	return &GetPullStatusRequest{PullId: in.GetPullId()}
+}
+
+func ToShardGetPullStatusResponse(in *internal.GetPullStatus_Response) *GetPullStatusResponse {
+	if in == nil {
+		return nil
+	}
+	return &GetPullStatusResponse{
+		PullId:              in.GetPullId(),
+		ElapsedTime:         time.Duration(in.GetElapsedTime()) * time.Millisecond,
+		QueuedTaskCount:     in.GetQueuedTaskCount(),
+		UnfinishedTaskCount: in.GetUnfinishedTaskCount(),
+		RunningTaskCount:    in.GetRunningTaskCount(),
+		FinishedTables:      in.GetFinishedTables(),
+		TableCount:          in.GetTableCount(),
+		CancelledTables:     in.GetCancelledTables(),
+		Diagnostics:         ToShardDiagnostics(in.GetDiagnostics()),
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/selefra/selefra-provider-sdk/grpc/shard"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
 	"github.com/selefra/selefra-provider-sdk/provider/transformer"
+	"github.com/selefra/selefra-provider-sdk/storage_factory"
 )
 
 // Provider To represent a source from which data can be retrieved, the Provider's implementation is to declare and configure this struct
@@ -37,6 +38,18 @@ type Provider struct {
 
 	ErrorsHandlerMeta schema.ErrorsHandlerMeta
 
+	// The memory limit when pulling tables, in MB, the pull request can override it,
+	// if neither is set, the limit is detected from the cgroup, otherwise use schema.DefaultMaxMemoryMB
+	MaxMemoryMB uint64
+
+	// Where the rows go when the pull request is a dry-run, if not set, the rows are written as JSON lines to a file
+	// in the DryRunOutputDirectory of the workspace, use NewStdoutJsonRowSink or NewMemoryRowSink when developing the provider
+	DryRunSink RowSink
+
+	// Where the spans of the pulls go, the pull is not traced if not set, use schema.NewJsonFileSpanExporter to write them to a file,
+	// or schema.NewOtlpHttpSpanExporter to send them to an OpenTelemetry collector
+	SpanExporter schema.SpanExporter
+
 	runtime *ProviderRuntime
 }
 
@@ -160,6 +173,79 @@ func (x *Provider) SetProviderConfig(ctx context.Context, request *shard.SetProv
 	}, nil
 }
 
+// CheckConfig Check a provider config without any side effect, the storage, the clients and the config in use are not changed,
+// it does not need Init
+func (x *Provider) CheckConfig(ctx context.Context, request *shard.CheckConfigRequest) (response *shard.CheckConfigResponse, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			response = &shard.CheckConfigResponse{
+				Diagnostics: schema.NewDiagnostics().AddErrorMsg("exec provider CheckConfig panic: %s", r),
+			}
+		}
+	}()
+
+	return &shard.CheckConfigResponse{
+		Diagnostics: x.ConfigMeta.CheckConfig(ctx, request.Config, request.IsProbeCredentials),
+	}, nil
+}
+
+// GetCapabilities The optional features and the storage types this provider supports, it does not need Init
+func (x *Provider) GetCapabilities(ctx context.Context, request *shard.GetCapabilitiesRequest) (response *shard.GetCapabilitiesResponse, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			response = &shard.GetCapabilitiesResponse{
+				ProtocolVersion: shard.CurrentProtocolVersion,
+				Diagnostics:     schema.NewDiagnostics().AddErrorMsg("exec provider GetCapabilities panic: %s", r),
+			}
+		}
+	}()
+
+	features := []string{
+		shard.FeatureCheckConfig,
+		shard.FeatureCancelPull,
+		shard.FeatureCancelTable,
+		shard.FeaturePullStatus,
+		shard.FeatureQuery,
+		shard.FeatureDryRun,
+		shard.FeatureCheckpoint,
+		shard.FeatureSampling,
+	}
+	if x.ConfigMeta.CheckCredentials != nil {
+		features = append(features, shard.FeatureCheckCredentials)
+	}
+	if hasTable(x.TableList, func(table *schema.Table) bool { return table.GetIncrementalCursorColumn() != "" }) {
+		features = append(features, shard.FeatureIncremental)
+	}
+	if hasTable(x.TableList, func(table *schema.Table) bool { return len(table.Parameters) != 0 }) {
+		features = append(features, shard.FeatureTableParameters)
+	}
+
+	storageTypes := make([]shard.StorageType, 0)
+	for _, storageType := range storage_factory.GetRegisteredStorageTypes() {
+		if shardStorageType, ok := shard.ToShardStorageType(storageType); ok {
+			storageTypes = append(storageTypes, shardStorageType)
+		}
+	}
+
+	return &shard.GetCapabilitiesResponse{
+		ProtocolVersion: shard.CurrentProtocolVersion,
+		Features:        features,
+		StorageTypes:    storageTypes,
+	}, nil
+}
+
+// Whether any table or sub table matches
+func hasTable(tables []*schema.Table, match func(table *schema.Table) bool) bool {
+	for _, table := range tables {
+		if match(table) || hasTable(table.SubTables, match) {
+			return true
+		}
+	}
+	return false
+}
+
 // -------------------------------------------------------------------------------------------------------------------------
 
 // PullTables Pull the given resource
@@ -184,6 +270,28 @@ func (x *Provider) PullTables(ctx context.Context, request *shard.PullTablesRequ
 	return x.runtime.PullTables(ctx, request, sender)
 }
 
+// Query Run a read-only query on the provider's storage, the rows are streamed back in batches
+func (x *Provider) Query(ctx context.Context, request *shard.QueryRequest, sender shard.QueryServerSender) (err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			x.ClientMeta.ErrorF("exec Query panic: %v", r)
+			err = sender.Send(&shard.QueryResponse{
+				Diagnostics: schema.NewDiagnostics().AddErrorMsg("exec Query panic: %s", r),
+			})
+		}
+	}()
+
+	// runtime must already init
+	if x.runtime == nil {
+		return sender.Send(&shard.QueryResponse{
+			Diagnostics: schema.NewDiagnostics().AddErrorMsg(ErrMsgNotInitRuntime),
+		})
+	}
+
+	return x.runtime.Query(ctx, request, sender)
+}
+
 // ------------------------------------------------- ------------------------------------------------------------------------
 
 func (x *Provider) DropTableAll(ctx context.Context, request *shard.ProviderDropTableAllRequest) (response *shard.ProviderDropTableAllResponse, err error) {
@@ -214,6 +322,68 @@ func (x *Provider) CreateAllTables(ctx context.Context, request *shard.ProviderC
 
 // ------------------------------------------------- ------------------------------------------------------------------------
 
+// CancelPull Cancel a running pull by the pull id returned in the first message of PullTables
+func (x *Provider) CancelPull(ctx context.Context, request *shard.CancelPullRequest) (response *shard.CancelPullResponse, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			response = &shard.CancelPullResponse{
+				Diagnostics: schema.NewDiagnostics().AddErrorMsg("exec provider CancelPull panic: %s", r),
+			}
+		}
+	}()
+
+	if x.runtime == nil {
+		return &shard.CancelPullResponse{
+			Diagnostics: schema.NewDiagnostics().AddErrorMsg(ErrMsgNotInitRuntime),
+		}, nil
+	}
+
+	return &shard.CancelPullResponse{Diagnostics: x.runtime.CancelPull(ctx, request.PullId)}, nil
+}
+
+// GetPullStatus The progress of a running pull
+func (x *Provider) GetPullStatus(ctx context.Context, request *shard.GetPullStatusRequest) (response *shard.GetPullStatusResponse, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			response = &shard.GetPullStatusResponse{
+				Diagnostics: schema.NewDiagnostics().AddErrorMsg("exec provider GetPullStatus panic: %s", r),
+			}
+		}
+	}()
+
+	if x.runtime == nil {
+		return &shard.GetPullStatusResponse{
+			Diagnostics: schema.NewDiagnostics().AddErrorMsg(ErrMsgNotInitRuntime),
+		}, nil
+	}
+
+	return x.runtime.GetPullStatus(ctx, request.PullId), nil
+}
+
+// CancelTable Cancel a root table of a running pull, and its sub tables with it
+func (x *Provider) CancelTable(ctx context.Context, request *shard.CancelTableRequest) (response *shard.CancelTableResponse, err error) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			response = &shard.CancelTableResponse{
+				Diagnostics: schema.NewDiagnostics().AddErrorMsg("exec provider CancelTable panic: %s", r),
+			}
+		}
+	}()
+
+	if x.runtime == nil {
+		return &shard.CancelTableResponse{
+			Diagnostics: schema.NewDiagnostics().AddErrorMsg(ErrMsgNotInitRuntime),
+		}, nil
+	}
+
+	return &shard.CancelTableResponse{Diagnostics: x.runtime.CancelTable(ctx, request.PullId, request.Table)}, nil
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
 func (x *Provider) Init(ctx context.Context, request *shard.ProviderInitRequest) (response *shard.ProviderInitResponse, err error) {
 
 	defer func() {
@@ -273,8 +443,10 @@ func (x *Provider) Init(ctx context.Context, request *shard.ProviderInitRequest)
 	_ = reflect_util.SetStructPtrUnExportedStrField(&x.ErrorsHandlerMeta, "runtime", errorHandlerMetaRuntime)
 	x.ClientMeta.Debug("init error handler runtime success")
 
-	// init runtime storage, must after client meta init done
-	if diagnostics.AddDiagnostics(runtime.initStorage(ctx, request.Storage, &x.ClientMeta)).HasError() {
+	// init runtime storage, must after client meta init done, without storage only the dry-run pull is available
+	if request.Storage == nil {
+		diagnostics.AddInfo("storage is not given, only dry-run pull is available")
+	} else if diagnostics.AddDiagnostics(runtime.initStorage(ctx, request.Storage, &x.ClientMeta)).HasError() {
 		return &shard.ProviderInitResponse{
 			Diagnostics: diagnostics,
 		}, nil
@@ -298,7 +470,7 @@ func (x *Provider) Init(ctx context.Context, request *shard.ProviderInitRequest)
 	} else {
 		if x.TransformerMeta.IsUseDefaultColumnValueConvertor() {
 			columnValueConvertor = column_value_convertor.NewDefaultTypeConvertor(&x.ClientMeta, x.TransformerMeta.DefaultColumnValueConvertorBlackList)
-		} else {
+		} else if x.runtime.storage != nil {
 			columnValueConvertor = x.runtime.storage.NewColumnValueConvertor()
 		}
 		if columnValueConvertor == nil {
@@ -16,9 +16,36 @@ type ConfigMeta struct {
 	// It is highly recommended that you configure this function when working with configuration files
 	Validation func(ctx context.Context, config *viper.Viper) *schema.Diagnostics
 
+	// Check the credentials in the config can access the API, for example call an API that returns who the caller is,
+	// it is called by CheckConfig when the host asks to probe the credentials, it must not change anything
+	CheckCredentials func(ctx context.Context, config *viper.Viper) *schema.Diagnostics
+
 	runtime *ConfigMetaRuntime
 }
 
 func (x *ConfigMeta) Runtime() *ConfigMetaRuntime {
 	return x.runtime
 }
+
+// CheckConfig Parse and validate the config, and probe the credentials in it if asked, the config is not saved,
+// so it can be called before Init or while the provider is pulling with another config
+func (x *ConfigMeta) CheckConfig(ctx context.Context, configYamlString string, isProbeCredentials bool) *schema.Diagnostics {
+	diagnostics := schema.NewDiagnostics()
+
+	config, d := parseProviderConfig(configYamlString)
+	if diagnostics.AddDiagnostics(d).HasError() {
+		return diagnostics
+	}
+
+	if x.Validation != nil && diagnostics.AddDiagnostics(x.Validation(ctx, config)).HasError() {
+		return diagnostics
+	}
+
+	if !isProbeCredentials {
+		return diagnostics
+	}
+	if x.CheckCredentials == nil {
+		return diagnostics.AddWarn("the provider does not support to check the credentials, they are checked when the pull begins")
+	}
+	return diagnostics.AddDiagnostics(x.CheckCredentials(ctx, config))
+}
@@ -63,12 +63,8 @@ func (x *ConfigMetaRuntime) UpdateProviderConfig(ctx context.Context, configYaml
 	}
 
 	// parser config file
-	configViper := viper.New()
-	configViper.SetConfigType("yaml")
-	err := configViper.ReadConfig(strings.NewReader(*configYamlString))
-	if err != nil {
-		diagnostics.AddErrorMsg("config init error: parser yaml error, %s", err.Error())
-
+	configViper, d := parseProviderConfig(*configYamlString)
+	if diagnostics.AddDiagnostics(d).HasError() {
 		return
 	}
 	x.providerConfigYamlString = *configYamlString
@@ -81,3 +77,13 @@ func (x *ConfigMetaRuntime) UpdateProviderConfig(ctx context.Context, configYaml
 
 	return
 }
+
+// Parse the YAML of the provider config
+func parseProviderConfig(configYamlString string) (*viper.Viper, *schema.Diagnostics) {
+	configViper := viper.New()
+	configViper.SetConfigType("yaml")
+	if err := configViper.ReadConfig(strings.NewReader(configYamlString)); err != nil {
+		return nil, schema.NewDiagnostics().AddErrorMsg("config init error: parser yaml error, %s", err.Error())
+	}
+	return configViper, nil
+}
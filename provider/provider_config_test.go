@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider_CheckConfig(t *testing.T) {
+	probeCount := 0
+	myProvider := &Provider{
+		Name:    "test-check-config-provider",
+		Version: "v0.0.1",
+		ConfigMeta: ConfigMeta{
+			Validation: func(ctx context.Context, config *viper.Viper) *schema.Diagnostics {
+				if config.GetString("token") == "" {
+					return schema.NewDiagnostics().AddErrorMsg("token is required")
+				}
+				return nil
+			},
+			CheckCredentials: func(ctx context.Context, config *viper.Viper) *schema.Diagnostics {
+				probeCount++
+				if config.GetString("token") != "valid" {
+					return schema.NewDiagnostics().AddErrorMsg("token is not valid")
+				}
+				return nil
+			},
+		},
+	}
+
+	// no Init is needed
+	check := func(config string, isProbeCredentials bool) *schema.Diagnostics {
+		response, err := myProvider.CheckConfig(context.Background(), &shard.CheckConfigRequest{Config: config, IsProbeCredentials: isProbeCredentials})
+		assert.Nil(t, err)
+		return response.Diagnostics
+	}
+
+	assert.True(t, check("token: [", false).HasError())
+	assert.True(t, check("region: us-east-1", false).HasError())
+	assert.False(t, check("token: invalid", false).HasError())
+	assert.Equal(t, 0, probeCount)
+
+	assert.True(t, check("token: invalid", true).HasError())
+	assert.False(t, check("token: valid", true).HasError())
+	assert.Equal(t, 2, probeCount)
+
+	// the config in use is not changed
+	assert.Nil(t, myProvider.ConfigMeta.Runtime())
+
+	// the credentials can not be checked
+	myProvider.ConfigMeta.CheckCredentials = nil
+	d := check("token: valid", true)
+	assert.False(t, d.HasError())
+	assert.Equal(t, 1, d.Size())
+}
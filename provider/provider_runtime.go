@@ -2,14 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/selefra/selefra-utils/pkg/reflect_util"
 	"go.uber.org/zap"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/selefra/selefra-utils/pkg/md5_util"
 	"github.com/selefra/selefra-utils/pkg/string_util"
 
 	"github.com/selefra/selefra-provider-sdk/grpc/shard"
@@ -38,6 +44,9 @@ type ProviderRuntime struct {
 
 	// The converter currently used by this provider
 	transformer *transformer.Transformer
+
+	// The pulls that are running, so that they can be checked or cancelled by the pull id
+	pullRegistry *pullRegistry
 }
 
 func NewProviderRuntime(ctx context.Context, myProvider *Provider) (runtime *ProviderRuntime, diagnostics *schema.Diagnostics) {
@@ -45,7 +54,8 @@ func NewProviderRuntime(ctx context.Context, myProvider *Provider) (runtime *Pro
 	diagnostics = schema.NewDiagnostics()
 	runtime = &ProviderRuntime{
 		// bind this context
-		myProvider: myProvider,
+		myProvider:   myProvider,
+		pullRegistry: newPullRegistry(),
 	}
 
 	return
@@ -151,30 +161,107 @@ func (x *ProviderRuntime) PullTables(ctx context.Context, request *shard.PullTab
 
 	diagnostics := schema.NewDiagnostics()
 
-	// Data sources must be initialized before resources can be pulled
-	if x.storage == nil {
+	// Each message of the pull carries the pull id
+	pullId := id_util.RandomId()
+	sender = &pullIdSender{pullId: pullId, sender: sender}
+
+	// Data sources must be initialized before resources can be pulled, except the dry-run pull which does not touch the storage
+	if x.storage == nil && !request.DryRun {
 		diagnostics.AddErrorMsg(errorMessageStorageNotInit)
 		x.myProvider.ClientMeta.DebugF("pull table exit, occur error: %s", diagnostics.ToString())
 		return sender.Send(x.buildPullTablesResponseWithDiagnostics(diagnostics))
 	}
 
 	// Calculate and verify the table to be pulled
-	pullTables, d := x.computeNeedPullRootTables(request.Tables)
+	pullTables, d := x.computeNeedPullRootTables(request)
 	if diagnostics.AddDiagnostics(d).HasError() {
 		x.myProvider.ClientMeta.DebugF("pull table exit, occur error: %s", diagnostics.ToString())
 		return sender.Send(x.buildPullTablesResponseWithDiagnostics(diagnostics))
 	}
 
+	// The parameters are checked before anything is pulled
+	pullTables, tableParameters, d := x.computeTableParameters(request, pullTables)
+	if diagnostics.AddDiagnostics(d).HasError() {
+		x.myProvider.ClientMeta.DebugF("pull table exit, occur error: %s", diagnostics.ToString())
+		return sender.Send(x.buildPullTablesResponseWithDiagnostics(diagnostics))
+	}
+
+	// The pull stop when the host cancel the rpc or the timeout is reached, all tasks share this context
+	pullCtx, cancelFunc := x.buildPullContext(ctx, request.Timeout)
+	defer cancelFunc()
+
 	// Create a data source task actuator
 	dataSourceExecutor, d := schema.NewDataSourcePullExecutor(request.MaxGoroutines, &x.myProvider.ClientMeta, &x.myProvider.ErrorsHandlerMeta)
 	if diagnostics.AddDiagnostics(d).HasError() {
 		x.myProvider.ClientMeta.DebugF("pull table exit, occur error: %s", diagnostics.ToString())
 		return sender.Send(x.buildPullTablesResponseWithDiagnostics(diagnostics))
 	}
+	maxMemoryMB := x.myProvider.MaxMemoryMB
+	if request.MaxMemoryMB > 0 {
+		maxMemoryMB = request.MaxMemoryMB
+	}
+	dataSourceExecutor.SetMemoryGovernor(schema.NewMemoryGovernor(maxMemoryMB, &x.myProvider.ClientMeta))
+	dataSourceExecutor.SetSampling(schema.NewPullSampling(request.SampleResultLimit, request.SampleChildTaskLimit))
+	dataSourceExecutor.SetTableParameters(tableParameters)
+
+	// The dry-run pull sends the rows to the sink, and leaves nothing in the storage, include the checkpoint and watermarks
+	resultHandler := x.resultHandler
+	var checkpoint *schema.PullCheckpoint
+	var watermark *schema.PullWatermark
+	if request.DryRun {
+		sink, closeSink, d := x.buildDryRunSink()
+		if diagnostics.AddDiagnostics(d).HasError() {
+			x.myProvider.ClientMeta.DebugF("pull table exit, occur error: %s", diagnostics.ToString())
+			return sender.Send(x.buildPullTablesResponseWithDiagnostics(diagnostics))
+		}
+		defer closeSink()
+		resultHandler = x.buildDryRunResultHandler(sink)
+	} else {
+		// The progress is saved to the storage only when asked, so that an interrupted pull can be resumed
+		if request.Checkpoint || request.Resume {
+			checkpoint = schema.NewPullCheckpoint(x.storage, x.buildCheckpointKey(request), &x.myProvider.ClientMeta)
+			if request.Resume {
+				d := checkpoint.Load(pullCtx)
+				if diagnostics.AddDiagnostics(d).HasError() {
+					x.myProvider.ClientMeta.DebugF("pull table exit, occur error: %s", diagnostics.ToString())
+					return sender.Send(x.buildPullTablesResponseWithDiagnostics(diagnostics))
+				}
+			}
+			dataSourceExecutor.SetCheckpoint(checkpoint)
+		}
+
+		// The incremental tables only pull the records newer than the watermarks of the last successful pull
+		watermark = schema.NewPullWatermark(x.storage, x.buildWatermarkKeyPrefix(), &x.myProvider.ClientMeta).SetFullResync(request.FullResync)
+		dataSourceExecutor.SetWatermark(watermark)
+	}
+
+	// All the task spans are the children of the pull span, so the whole pull is one trace
+	var tracer *schema.Tracer
+	if x.myProvider.SpanExporter != nil {
+		tracer = schema.NewTracer(x.myProvider.SpanExporter)
+	}
+	pullCtx, pullSpan := tracer.StartSpan(pullCtx, "pull_tables")
+	if pullSpan != nil {
+		pullSpan.SetAttribute("pull_id", pullId).SetAttribute("provider", x.myProvider.Name).SetAttribute("dry_run", request.DryRun)
+		x.myProvider.ClientMeta.DebugF("pull %s, traceId = %s", pullId, pullSpan.TraceId)
+	}
 
+	// The host can check or cancel the pull by the pull id while it is running
 	totalTableCount := x.computeAllNeedPullTablesCount(pullTables...)
-	finishTable := make(map[string]bool, 0)
-	finishTableLock := sync.RWMutex{}
+	pull := newRunningPull(pullId, cancelFunc, dataSourceExecutor, totalTableCount)
+	x.pullRegistry.register(pull)
+	defer x.pullRegistry.unregister(pullId)
+	defer pull.releaseTableContexts()
+	finishTable := pull.finishTable
+	finishTableLock := &pull.finishTableLock
+
+	// The first message tells the host the pull id as soon as the pull starts
+	if err := sender.Send(&shard.PullTablesResponse{
+		FinishedTables: map[string]bool{},
+		TableCount:     totalTableCount,
+	}); err != nil {
+		x.myProvider.ClientMeta.ErrorF("send rpc message error: %s", err.Error())
+	}
 
 	// Collect some information from the fetching process and so on
 	diagnosticsChannel := make(chan *schema.Diagnostics, 1000)
@@ -205,32 +292,52 @@ func (x *ProviderRuntime) PullTables(ctx context.Context, request *shard.PullTab
 	// The tables to be pulled are then submitted in turn
 	for _, table := range pullTables {
 
+		// The root table completed by the last interrupted pull is not pulled again
+		if checkpoint != nil && checkpoint.IsRootTableCompleted(table.TableName) {
+			finishTableLock.Lock()
+			for _, tableName := range x.flatTable(table) {
+				finishTable[tableName] = true
+			}
+			finishTableLock.Unlock()
+			x.myProvider.ClientMeta.DebugF("table %s is completed in checkpoint, skip it", table.TableName)
+			continue
+		}
+
+		// Each root table has its own context, so that it can be cancelled alone by CancelTable
+		tableCtx := pull.buildTableContext(pullCtx, table.TableName)
 		task := &schema.DataSourcePullTask{
 			TaskId:             id_util.RandomId(),
-			Ctx:                context.Background(),
+			Ctx:                tableCtx,
 			Table:              table,
 			DiagnosticsChannel: diagnosticsChannel,
-			ResultHandler:      x.resultHandler,
-			TaskDoneCallback: func(ctx context.Context, clientMeta *schema.ClientMeta, task *schema.DataSourcePullTask) *schema.Diagnostics {
-				table := task.Table
+			ResultHandler:      resultHandler,
+			// Called once for the root table and each of its sub tables, when all their clients and child tasks are done
+			TableDoneCallback: func(ctx context.Context, clientMeta *schema.ClientMeta, task *schema.DataSourcePullTask, table *schema.Table) *schema.Diagnostics {
 				finishTableLock.Lock()
 				defer finishTableLock.Unlock()
 
 				finishTable[table.TableName] = true
 
+				// The progress message carries the statistics of the finished table
+				var tableStatistics map[string]*schema.PullTableStatistics
+				if statistics := dataSourceExecutor.Statistics().TableSnapshot(table.TableName); statistics != nil {
+					tableStatistics = map[string]*schema.PullTableStatistics{table.TableName: statistics}
+				}
+
 				err := sender.Send(&shard.PullTablesResponse{
-					FinishedTables: finishTable,
-					TableCount:     totalTableCount,
-					Table:          table.TableName,
-					Diagnostics:    nil,
+					FinishedTables:  finishTable,
+					TableCount:      totalTableCount,
+					Table:           table.TableName,
+					Diagnostics:     nil,
+					TableStatistics: tableStatistics,
 				})
 
 				if err != nil {
 					clientMeta.ErrorF("taskId = %s, send rpc error: %s", task.TaskId, err)
-					return schema.NewDiagnostics().AddErrorMsg("table %s task done, send rpc error: %s", task.Table.TableName, err.Error())
+					return schema.NewDiagnostics().AddErrorMsg("table %s done, send rpc error: %s", table.TableName, err.Error())
 				}
 
-				clientMeta.DebugF("taskId = %s, table = %s, send finished rpc done.", table.TableName, task.TaskId)
+				clientMeta.DebugF("taskId = %s, table = %s, send finished rpc done.", task.TaskId, table.TableName)
 
 				return nil
 			},
@@ -238,24 +345,200 @@ func (x *ProviderRuntime) PullTables(ctx context.Context, request *shard.PullTab
 			IsExpandDone: false,
 			Client:       nil,
 		}
-		diagnostics.AddDiagnostics(dataSourceExecutor.Submit(context.Background(), task))
+		diagnostics.AddDiagnostics(dataSourceExecutor.Submit(tableCtx, task))
 		// taskId --> tableName relation, after just use taskId
 		x.myProvider.ClientMeta.DebugF("taskId = %s, commit task to executor, table name = %s", task.TaskId, task.Table.TableName)
 	}
 	x.myProvider.ClientMeta.DebugF("all task submit to executor done, shutdown and wait...")
 
-	diagnostics.AddDiagnostics(dataSourceExecutor.ShutdownAndAwaitTermination(context.Background()))
-	diagnosticsChannel <- diagnostics
+	diagnostics.AddDiagnostics(dataSourceExecutor.ShutdownAndAwaitTermination(pullCtx))
+
+	// The throttle wait time is part of the pull statistics, so that the user knows why the pull is slow
+	statistics := dataSourceExecutor.Statistics()
+	statistics.SetThrottleWaitTime(dataSourceExecutor.RateLimiter().ThrottleWaitTime())
+
+	// The clients skipped because of repeated auth failures
+	openClients := dataSourceExecutor.CircuitBreaker().OpenClients()
+	statistics.SetCircuitOpen(openClients)
+
+	// Tell the host which tables are cut off if the pull or any table is cancelled, or any client is skipped, otherwise the checkpoint is useless now
+	if pullCtx.Err() != nil || len(pull.getCancelledTables()) > 0 || len(openClients) > 0 {
+		finishTableLock.RLock()
+		diagnostics.AddDiagnostics(x.buildCutOffTablesDiagnostics(pullCtx, pullTables, finishTable, statistics, openClients))
+		finishTableLock.RUnlock()
+	} else if !request.DryRun {
+		diagnostics.AddDiagnostics(watermark.Save(ctx))
+		// The checkpoint left by an earlier interrupted pull is stale now, even if this pull did not save one
+		if checkpoint == nil {
+			checkpoint = schema.NewPullCheckpoint(x.storage, x.buildCheckpointKey(request), &x.myProvider.ClientMeta)
+		}
+		diagnostics.AddDiagnostics(checkpoint.Clear(ctx))
+	}
 
 	close(diagnosticsChannel)
 	wg.Wait()
 
+	pullSpan.SetDiagnostics(diagnostics).End()
+	if err := tracer.Flush(ctx); err != nil {
+		diagnostics.AddWarn("export the trace of pull %s error: %s", pullId, err.Error())
+	}
+
+	// The last message is the summary of the pull
+	finishTableLock.RLock()
+	err := sender.Send(&shard.PullTablesResponse{
+		FinishedTables:  finishTable,
+		TableCount:      totalTableCount,
+		Table:           "",
+		Diagnostics:     diagnostics,
+		TableStatistics: statistics.Snapshot(),
+	})
+	finishTableLock.RUnlock()
+	if err != nil {
+		x.myProvider.ClientMeta.ErrorF("send rpc message error: %s", err.Error())
+	}
+
 	x.myProvider.ClientMeta.DebugF("pull table queue done, exit function")
 
 	return nil
 }
 
+// CancelPull Cancel the running pull, the PullTables returns with the cut off tables as if the host cancelled the rpc
+func (x *ProviderRuntime) CancelPull(ctx context.Context, pullId string) *schema.Diagnostics {
+	pull, d := x.pullRegistry.get(pullId)
+	if d != nil {
+		return d
+	}
+	pull.cancel()
+	x.myProvider.ClientMeta.DebugF("pull %s is cancelled", pullId)
+	return nil
+}
+
+// CancelTable Cancel a root table of the running pull, the tasks of the root table and its sub tables stop, the other tables keep running
+func (x *ProviderRuntime) CancelTable(ctx context.Context, pullId, tableName string) *schema.Diagnostics {
+	pull, d := x.pullRegistry.get(pullId)
+	if d != nil {
+		return d
+	}
+	if d := pull.cancelTable(tableName); d != nil {
+		return d
+	}
+	x.myProvider.ClientMeta.DebugF("pull %s table %s is cancelled", pullId, tableName)
+	return nil
+}
+
+// GetPullStatus The progress of the running pull
+func (x *ProviderRuntime) GetPullStatus(ctx context.Context, pullId string) *shard.GetPullStatusResponse {
+	pull, d := x.pullRegistry.get(pullId)
+	if d != nil {
+		return &shard.GetPullStatusResponse{PullId: pullId, Diagnostics: d}
+	}
+	return pull.status()
+}
+
+// The timeout of pull request is in milliseconds, less than or equal to 0 means no timeout
+func (x *ProviderRuntime) buildPullContext(ctx context.Context, timeout int64) (context.Context, context.CancelFunc) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, time.Millisecond*time.Duration(timeout))
+}
+
+// PullCheckpointKeyPrefix The checkpoint of the pull is saved in the key value storage with this prefix
+const PullCheckpointKeyPrefix = "selefra_pull_checkpoint_"
+
+// The pulls of the same provider and the same tables share one checkpoint
+func (x *ProviderRuntime) buildCheckpointKey(request *shard.PullTablesRequest) string {
+	sortedJoin := func(values []string) string {
+		sortedValues := make([]string, len(values))
+		copy(sortedValues, values)
+		sort.Strings(sortedValues)
+		return strings.Join(sortedValues, ",")
+	}
+	tables := sortedJoin(request.Tables)
+	// The pull selecting other tables has its own checkpoint
+	if len(request.ExcludeTables) != 0 || len(request.Tags) != 0 || len(request.ExcludeTags) != 0 {
+		tables += "|" + sortedJoin(request.ExcludeTables) + "|" + sortedJoin(request.Tags) + "|" + sortedJoin(request.ExcludeTags)
+	}
+	// The pull with other parameters pulls other data, so it has its own checkpoint, the map keys are sorted by json
+	if len(request.TableParameters) != 0 {
+		if parametersJson, err := json.Marshal(request.TableParameters); err == nil {
+			tables += "?" + string(parametersJson)
+		}
+	}
+	tablesMd5, err := md5_util.Md5String(tables)
+	if err != nil {
+		tablesMd5 = tables
+	}
+	return PullCheckpointKeyPrefix + x.myProvider.Name + "_" + tablesMd5
+}
+
+// DryRunOutputDirectory The rows of the dry-run pull are written to this directory in the workspace when the provider has no DryRunSink
+const DryRunOutputDirectory = "dry_run"
+
+// The sink of the dry-run pull, and how to release it after the pull
+func (x *ProviderRuntime) buildDryRunSink() (RowSink, func(), *schema.Diagnostics) {
+	if x.myProvider.DryRunSink != nil {
+		return x.myProvider.DryRunSink, func() {}, nil
+	}
+	path := filepath.Join(x.workspace, DryRunOutputDirectory, fmt.Sprintf("%s_%s.jsonl", x.myProvider.Name, time.Now().Format("20060102150405")))
+	sink, err := NewFileJsonRowSink(path)
+	if err != nil {
+		return nil, nil, schema.NewDiagnostics().AddErrorMsg("create dry-run output file %s error: %s", path, err.Error())
+	}
+	closeSink := func() {
+		if err := sink.Close(); err != nil {
+			x.myProvider.ClientMeta.ErrorF("close dry-run output file %s error: %s", path, err.Error())
+		}
+	}
+	return sink, closeSink, schema.NewDiagnostics().AddInfo("dry-run pull, the rows are written to %s", path)
+}
+
+// PullWatermarkKeyPrefix The watermarks of the incremental tables are saved in the key value storage with this prefix
+const PullWatermarkKeyPrefix = "selefra_pull_watermark_"
+
+// The watermarks belong to the table and client, not the pull, so they are shared by all pulls of the same provider
+func (x *ProviderRuntime) buildWatermarkKeyPrefix() string {
+	return PullWatermarkKeyPrefix + x.myProvider.Name + "_"
+}
+
+// The tables that are not finished when the pull context is done
+func (x *ProviderRuntime) buildCutOffTablesDiagnostics(pullCtx context.Context, pullTables []*schema.Table, finishTable map[string]bool, statistics *schema.PullStatistics, openClients []string) *schema.Diagnostics {
+	cutOffTables := make([]string, 0)
+	for _, table := range pullTables {
+		for _, tableName := range x.flatTable(table) {
+			if !finishTable[tableName] {
+				cutOffTables = append(cutOffTables, tableName)
+				statistics.SetCutOff(tableName)
+			}
+		}
+	}
+	if len(cutOffTables) == 0 {
+		return nil
+	}
+	reason := "cancelled"
+	if errors.Is(pullCtx.Err(), context.DeadlineExceeded) {
+		reason = "timeout"
+	} else if pullCtx.Err() == nil && len(openClients) > 0 {
+		reason = fmt.Sprintf("incomplete, the tasks of the clients %s are skipped after repeated auth failures", strings.Join(openClients, ", "))
+	}
+	return schema.NewDiagnostics().AddErrorMsg("pull tables %s, these tables are not finished: %s", reason, strings.Join(cutOffTables, ", "))
+}
+
 func (x *ProviderRuntime) resultHandler(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, result any) (*schema.Rows, []any, *schema.Diagnostics) {
+	return x.handleResult(ctx, clientMeta, client, task, result, x.storage)
+}
+
+// The result handler of the dry-run pull, the rows are transformed as usual but sent to the sink, the sub tables still expand on them
+func (x *ProviderRuntime) buildDryRunResultHandler(sink RowSink) func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, result any) (*schema.Rows, []any, *schema.Diagnostics) {
+	return func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, result any) (*schema.Rows, []any, *schema.Diagnostics) {
+		return x.handleResult(ctx, clientMeta, client, task, result, sink)
+	}
+}
+
+func (x *ProviderRuntime) handleResult(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, result any, sink RowSink) (*schema.Rows, []any, *schema.Diagnostics) {
 	diagnostics := schema.NewDiagnostics()
 
 	resultSlice := make([]any, 0)
@@ -284,7 +567,9 @@ func (x *ProviderRuntime) resultHandler(ctx context.Context, clientMeta *schema.
 	for _, result := range resultSlice {
 
 		// step 1. parser from raw result to row
-		row, d := x.transformSingleResult(ctx, clientMeta, client, task, result)
+		transformCtx, transformSpan := schema.StartSpan(ctx, "transform")
+		row, d := x.transformSingleResult(transformCtx, clientMeta, client, task, result)
+		transformSpan.SetDiagnostics(d).End()
 		diagnostics.Add(d)
 		if d != nil && d.HasError() {
 			// If an error occurs and ignore is configured, the end occurs
@@ -313,8 +598,18 @@ func (x *ProviderRuntime) resultHandler(ctx context.Context, clientMeta *schema.
 			continue
 		}
 
-		// step 2. save row to database
-		d = x.storage.Insert(ctx, task.Table, row.ToRows())
+		// The same primary keys are already saved in this pull, the row is dropped, so it does not expand the sub tables again
+		isDuplicate, d := task.IsDuplicateRow(row)
+		diagnostics.AddDiagnostics(d)
+		if isDuplicate {
+			clientMeta.DebugF("taskId = %s, resultHandler, drop duplicate row of table %s: %s", task.TaskId, task.Table.TableName, row.String())
+			continue
+		}
+
+		// step 2. save row to database, or the dry-run sink
+		insertCtx, insertSpan := schema.StartSpan(ctx, "insert")
+		d = sink.Insert(insertCtx, task.Table, row.ToRows())
+		insertSpan.SetDiagnostics(d).End()
 		diagnostics.AddDiagnostics(d)
 
 		if d != nil && d.HasError() {
@@ -338,6 +633,9 @@ func (x *ProviderRuntime) resultHandler(ctx context.Context, clientMeta *schema.
 				return nil, nil, diagnostics
 			}
 		} else {
+			// Only the saved row makes the later rows with the same primary keys duplicate
+			diagnostics.AddDiagnostics(task.RowSaved(row))
+
 			// merge rows
 			isRowsMergeSuccess := true
 			if saveSuccessRows == nil {
@@ -407,62 +705,199 @@ func (x *ProviderRuntime) buildPullTablesResponseWithDiagnostics(diagnostics *sc
 // AllTableNameWildcard Table name Wildcard, which matches all tables
 const AllTableNameWildcard = "*"
 
-// Calculate which tables to pull, and do some checking and expansion
-func (x *ProviderRuntime) computeNeedPullRootTables(tableNames []string) ([]*schema.Table, *schema.Diagnostics) {
+// Calculate which tables to pull, and do some checking and expansion, see the doc of shard.PullTablesRequest about how the tables are selected,
+// the returned root tables only have the selected sub tables and the ancestors they need
+func (x *ProviderRuntime) computeNeedPullRootTables(request *shard.PullTablesRequest) ([]*schema.Table, *schema.Diagnostics) {
 
 	diagnostics := schema.NewDiagnostics()
 
-	// for distinct
-	pullTableNameSet := make(map[string]struct{}, 0)
-	// What are the tables to pull
-	pullTables := make([]*schema.Table, 0)
+	includePatterns, d := compileTablePatterns(request.Tables)
+	diagnostics.AddDiagnostics(d)
+	excludePatterns, d := compileTablePatterns(request.ExcludeTables)
+	if diagnostics.AddDiagnostics(d).HasError() {
+		return nil, diagnostics
+	}
 
-	var isAllTableNameWildcard bool
+	// All the tables in order, and the parent of each table, the root table has no parent
+	allTables := make([]*schema.Table, 0)
+	parentTableMap := make(map[string]*schema.Table)
+	var walk func(parentTable, table *schema.Table)
+	walk = func(parentTable, table *schema.Table) {
+		allTables = append(allTables, table)
+		if parentTable != nil {
+			parentTableMap[table.TableName] = parentTable
+		}
+		for _, subTable := range table.SubTables {
+			walk(table, subTable)
+		}
+	}
+	for _, table := range x.myProvider.TableList {
+		walk(nil, table)
+	}
 
-	for _, tableName := range tableNames {
-		if tableName != AllTableNameWildcard {
-			continue
+	// A selected or excluded table takes all its sub tables with it
+	selectedTableSet := make(map[string]bool)
+	excludedTableSet := make(map[string]bool)
+	markTree := func(tableSet map[string]bool, table *schema.Table) {
+		for _, tableName := range x.flatTable(table) {
+			tableSet[tableName] = true
 		}
-		isAllTableNameWildcard = true
-		// If it is a wildcard, expand it
-		for tableName, table := range x.tableMap {
-			// distinct
-			if _, exists := pullTableNameSet[tableName]; exists {
-				continue
+	}
+	// the pattern or tag which matches nothing is most likely a typo
+	for _, pattern := range includePatterns {
+		matched := false
+		for _, table := range allTables {
+			if pattern.match(table.TableName) {
+				markTree(selectedTableSet, table)
+				matched = true
+			}
+		}
+		if !matched && pattern.isExact() {
+			diagnostics.AddErrorMsg("pull provider %s's table failed, because table %s not exists", x.myProvider.Name, pattern.raw)
+		} else if !matched {
+			diagnostics.AddErrorMsg("pull provider %s's table failed, because no table matches %s", x.myProvider.Name, pattern.raw)
+		}
+	}
+	for _, tag := range request.Tags {
+		matched := false
+		for _, table := range allTables {
+			if table.HasTag(tag) {
+				markTree(selectedTableSet, table)
+				matched = true
 			}
-			pullTables = append(pullTables, table)
-			pullTableNameSet[tableName] = struct{}{}
 		}
-		break
+		if !matched {
+			diagnostics.AddErrorMsg("pull provider %s's table failed, because no table has tag %s", x.myProvider.Name, tag)
+		}
 	}
-	if !isAllTableNameWildcard {
-		// nowTable: rootTable
-		tableRootMap := make(map[string]string, 0)
-		for rootTableName, rootTable := range x.tableMap {
-			for _, tableName := range x.flatTable(rootTable) {
-				tableRootMap[tableName] = rootTableName
+	for _, pattern := range excludePatterns {
+		matched := false
+		for _, table := range allTables {
+			if pattern.match(table.TableName) {
+				markTree(excludedTableSet, table)
+				matched = true
 			}
 		}
+		if !matched {
+			diagnostics.AddWarn("exclude tables %s matches no table of provider %s", pattern.raw, x.myProvider.Name)
+		}
+	}
+	for _, tag := range request.ExcludeTags {
+		matched := false
+		for _, table := range allTables {
+			if table.HasTag(tag) {
+				markTree(excludedTableSet, table)
+				matched = true
+			}
+		}
+		if !matched {
+			diagnostics.AddWarn("exclude tag %s matches no table of provider %s", tag, x.myProvider.Name)
+		}
+	}
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
 
-		for _, tableName := range tableNames {
-			// If it is not a wildcard character, it is a common table name
-			rootTableName, exists := tableRootMap[tableName]
+	// The selected sub table can not be pulled without its ancestors
+	keepTableSet := make(map[string]bool)
+	for _, table := range allTables {
+		if !selectedTableSet[table.TableName] || excludedTableSet[table.TableName] {
+			continue
+		}
+		for tableName := table.TableName; tableName != ""; {
+			keepTableSet[tableName] = true
+			parentTable, exists := parentTableMap[tableName]
 			if !exists {
-				diagnostics.AddErrorMsg("pull provider %s's table failed, because table %s not exists", x.myProvider.Name, tableName)
-				continue
+				break
 			}
+			tableName = parentTable.TableName
+		}
+	}
+	if len(selectedTableSet) != 0 && len(keepTableSet) == 0 {
+		diagnostics.AddErrorMsg("pull provider %s's table failed, because all the selected tables are excluded", x.myProvider.Name)
+		return nil, diagnostics
+	}
 
-			// distinct
-			if _, exists := pullTableNameSet[rootTableName]; exists {
-				continue
+	pullTables := make([]*schema.Table, 0)
+	for _, table := range x.myProvider.TableList {
+		if keepTableSet[table.TableName] {
+			pullTables = append(pullTables, pruneTable(table, keepTableSet))
+		}
+	}
+
+	// The tables are submitted after the tables they depend on
+	sortedPullTables, err := schema.SortTablesByDependencies(pullTables)
+	if err != nil {
+		return nil, diagnostics.AddErrorMsg("pull provider %s's table failed: %s", x.myProvider.Name, err.Error())
+	}
+	return sortedPullTables, diagnostics
+}
+
+// Check the parameters of the pulled tables, and the parameters can only be passed to the pulled tables. The required parameters
+// are only enforced on the tables the host cares about, that is named exactly in the request or given parameters, the other tables
+// with required parameters are selected by a pattern or as a sub table, they are skipped with a warning, so that "*" still works
+func (x *ProviderRuntime) computeTableParameters(request *shard.PullTablesRequest, pullTables []*schema.Table) ([]*schema.Table, map[string]schema.TableParameterValues, *schema.Diagnostics) {
+
+	diagnostics := schema.NewDiagnostics()
+	tableParameters := make(map[string]schema.TableParameterValues)
+	parameters := request.TableParameters
+
+	namedTableSet := make(map[string]bool)
+	includePatterns, _ := compileTablePatterns(request.Tables)
+	for _, pattern := range includePatterns {
+		if pattern.isExact() {
+			namedTableSet[pattern.raw] = true
+		}
+	}
+
+	pullTableNameSet := make(map[string]struct{})
+	keepTableSet := make(map[string]bool)
+	var walk func(table *schema.Table)
+	walk = func(table *schema.Table) {
+		pullTableNameSet[table.TableName] = struct{}{}
+		values, exists := parameters[table.TableName]
+		if !exists && !namedTableSet[table.TableName] && hasRequiredParameter(table) {
+			diagnostics.AddWarn("table %s is skipped, because it has required parameters but none is given", table.TableName)
+			return
+		}
+		keepTableSet[table.TableName] = true
+		if exists || len(table.Parameters) != 0 {
+			checkedValues, d := table.CheckParameters(values)
+			diagnostics.AddDiagnostics(d)
+			if len(checkedValues) != 0 {
+				tableParameters[table.TableName] = checkedValues
 			}
-			pullTables = append(pullTables, x.tableMap[rootTableName])
-			pullTableNameSet[rootTableName] = struct{}{}
+		}
+		for _, subTable := range table.SubTables {
+			walk(subTable)
+		}
+	}
+	for _, table := range pullTables {
+		walk(table)
+	}
+
+	for tableName := range parameters {
+		if _, exists := pullTableNameSet[tableName]; !exists {
+			diagnostics.AddErrorMsg("pull provider %s's table failed, because table %s has parameters but is not pulled", x.myProvider.Name, tableName)
+		}
+	}
 
+	keepPullTables := make([]*schema.Table, 0, len(pullTables))
+	for _, table := range pullTables {
+		if keepTableSet[table.TableName] {
+			keepPullTables = append(keepPullTables, pruneTable(table, keepTableSet))
 		}
 	}
+	return keepPullTables, tableParameters, diagnostics
+}
 
-	return pullTables, diagnostics
+func hasRequiredParameter(table *schema.Table) bool {
+	for _, parameter := range table.Parameters {
+		if parameter.IsRequired {
+			return true
+		}
+	}
+	return false
 }
 
 func (x *ProviderRuntime) computeAllNeedPullTablesCount(tables ...*schema.Table) uint64 {
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-provider-sdk/storage"
+)
+
+// DefaultQueryRowLimit How many rows are returned at most when the request does not specify a row limit
+const DefaultQueryRowLimit = 1000
+
+// MaxQueryRowLimit The row limit of the request can not exceed this
+const MaxQueryRowLimit = 100000
+
+// DefaultQueryTimeout The statement timeout when the request does not specify one
+const DefaultQueryTimeout = time.Second * 30
+
+// How many rows are sent in one response
+const queryResponseBatchSize = 100
+
+// Query Run a read-only query on the storage, only a single SELECT statement is allowed, it is run in a read-only transaction
+// that is always rolled back, the result is limited by row limit and timeout, and streamed back in batches
+func (x *ProviderRuntime) Query(ctx context.Context, request *shard.QueryRequest, sender shard.QueryServerSender) error {
+
+	diagnostics := schema.NewDiagnostics()
+
+	if x.storage == nil {
+		return sender.Send(&shard.QueryResponse{
+			Diagnostics: diagnostics.AddErrorMsg("provider storage not init, can not query"),
+		})
+	}
+
+	// the database makes sure the query is read-only, the storage that can not do that is not allowed to be queried
+	readOnlyQueryExecutor, ok := x.storage.(storage.ReadOnlyQueryExecutor)
+	if !ok {
+		return sender.Send(&shard.QueryResponse{
+			Diagnostics: diagnostics.AddErrorMsg("provider storage not support read-only query"),
+		})
+	}
+
+	// only a fast pre-check to reject the obvious writes with a clear message
+	if err := checkReadOnlyQuery(request.Query); err != nil {
+		return sender.Send(&shard.QueryResponse{
+			Diagnostics: diagnostics.AddErrorMsg("query rejected: %s", err.Error()),
+		})
+	}
+
+	rowLimit := request.RowLimit
+	if rowLimit == 0 {
+		rowLimit = DefaultQueryRowLimit
+	} else if rowLimit > MaxQueryRowLimit {
+		rowLimit = MaxQueryRowLimit
+	}
+	timeout := DefaultQueryTimeout
+	if request.Timeout > 0 {
+		timeout = time.Millisecond * time.Duration(request.Timeout)
+	}
+	queryCtx, cancelFunc := context.WithTimeout(ctx, timeout)
+	defer cancelFunc()
+
+	queryResult, d := readOnlyQueryExecutor.ReadOnlyQuery(queryCtx, timeout, request.Query)
+	if diagnostics.AddDiagnostics(d).HasError() {
+		return sender.Send(&shard.QueryResponse{Diagnostics: diagnostics})
+	}
+	defer func() {
+		queryResult.Close()
+	}()
+
+	// column names only sent with the first response
+	columnNames := queryResult.GetColumnNames()
+	rows := make([][]any, 0, queryResponseBatchSize)
+	rowCount := uint64(0)
+	isTruncated := false
+	for queryResult.Next() {
+		if rowCount >= rowLimit {
+			isTruncated = true
+			break
+		}
+		values, d := queryResult.Values()
+		if diagnostics.AddDiagnostics(d).HasError() {
+			break
+		}
+		rows = append(rows, values)
+		rowCount++
+		if len(rows) >= queryResponseBatchSize {
+			if err := sender.Send(&shard.QueryResponse{ColumnNames: columnNames, Rows: rows}); err != nil {
+				return err
+			}
+			columnNames = nil
+			rows = make([][]any, 0, queryResponseBatchSize)
+		}
+	}
+
+	// the driver stop iterating when context done, so it has to be checked here
+	if errors.Is(queryCtx.Err(), context.DeadlineExceeded) {
+		diagnostics.AddErrorMsg("query timeout after %s", timeout.String())
+	} else if !isTruncated {
+		// the statement timeout of the server, or the connection is lost
+		diagnostics.AddDiagnostics(queryResult.Err())
+	}
+
+	return sender.Send(&shard.QueryResponse{
+		ColumnNames: columnNames,
+		Rows:        rows,
+		IsTruncated: isTruncated,
+		Diagnostics: diagnostics,
+	})
+}
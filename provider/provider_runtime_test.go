@@ -162,3 +162,93 @@ func TestProviderRuntime_computeAllNeedPullTablesCount(t *testing.T) {
 	fmt.Println(count)
 
 }
+
+func TestProviderRuntime_PullTablesWithParameters(t *testing.T) {
+
+	type Instance struct {
+		Region string
+	}
+
+	table := &schema.Table{
+		TableName: "test_parameter_instances",
+		Columns: []*schema.Column{
+			{
+				ColumnName: "region",
+				Type:       schema.ColumnTypeString,
+				Extractor:  column_value_extractor.StructSelector("Region"),
+			},
+		},
+		Parameters: []*schema.TableParameter{
+			{Name: "regions", Type: schema.TableParameterTypeStringArray, IsRequired: true},
+		},
+		DataSource: schema.DataSource{
+			Pull: func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, resultChannel chan<- any) *schema.Diagnostics {
+				for _, region := range task.GetParameters().GetStringArray("regions") {
+					resultChannel <- &Instance{Region: region}
+				}
+				return nil
+			},
+		},
+	}
+
+	// a table without parameters, it is pulled by "*" with the table above
+	otherTable := &schema.Table{
+		TableName: "test_parameter_other",
+		Columns: []*schema.Column{
+			{
+				ColumnName: "region",
+				Type:       schema.ColumnTypeString,
+				Extractor:  column_value_extractor.StructSelector("Region"),
+			},
+		},
+		DataSource: schema.DataSource{
+			Pull: func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, resultChannel chan<- any) *schema.Diagnostics {
+				resultChannel <- &Instance{Region: "global"}
+				return nil
+			},
+		},
+	}
+
+	sink := NewMemoryRowSink()
+	provider := Provider{
+		Name:       "test-provider",
+		Version:    "v0.1",
+		TableList:  []*schema.Table{table, otherTable},
+		DryRunSink: sink,
+	}
+	initResponse, err := provider.Init(context.Background(), &shard.ProviderInitRequest{
+		Workspace:     pointer.ToStringPointer(t.TempDir()),
+		IsInstallInit: pointer.FalsePointer(),
+	})
+	assert.Nil(t, err)
+	assert.False(t, initResponse.Diagnostics.HasError(), initResponse.Diagnostics.ToString())
+
+	pullTables := func(tables []string, tableParameters map[string]map[string]any) *shard.PullTablesResponse {
+		sender := &testPullTablesSender{}
+		assert.Nil(t, provider.PullTables(context.Background(), &shard.PullTablesRequest{Tables: tables, DryRun: true, TableParameters: tableParameters}, sender))
+		return sender.responses[len(sender.responses)-1]
+	}
+	pull := func(tableParameters map[string]map[string]any) *shard.PullTablesResponse {
+		return pullTables([]string{"*"}, tableParameters)
+	}
+
+	// the required parameter is missing, the table selected by the pattern is skipped, the others are still pulled
+	response := pull(nil)
+	assert.False(t, response.Diagnostics.HasError(), response.Diagnostics.ToString())
+	assert.Contains(t, response.Diagnostics.ToString(), table.TableName)
+	assert.Len(t, sink.GetRows(table.TableName), 0)
+	assert.Len(t, sink.GetRows(otherTable.TableName), 1)
+	// the table named by the host must have the required parameter
+	assert.True(t, pullTables([]string{table.TableName}, nil).Diagnostics.HasError())
+	// the table with parameters is not pulled
+	assert.True(t, pull(map[string]map[string]any{table.TableName: {"regions": "us-east-1"}, "test_not_exists": {"id": "1"}}).Diagnostics.HasError())
+	assert.Len(t, sink.GetRows(table.TableName), 0)
+
+	response = pull(map[string]map[string]any{table.TableName: {"regions": []any{"us-east-1", "us-west-2"}}})
+	assert.False(t, response.Diagnostics.HasError(), response.Diagnostics.ToString())
+	regions := make([]string, 0)
+	for _, row := range sink.GetRows(table.TableName) {
+		regions = append(regions, row.GetStringOrDefault("region", ""))
+	}
+	assert.ElementsMatch(t, []string{"us-east-1", "us-west-2"}, regions)
+}
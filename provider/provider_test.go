@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider_GetCapabilities(t *testing.T) {
+	myProvider := &Provider{
+		Name:    "test-capabilities-provider",
+		Version: "v0.0.1",
+		TableList: []*schema.Table{
+			{
+				TableName: "test_capabilities_table",
+				SubTables: []*schema.Table{
+					{
+						TableName: "test_capabilities_sub_table",
+						Options:   &schema.TableOptions{IncrementalCursorColumn: "updated_at"},
+					},
+				},
+			},
+		},
+	}
+
+	// no Init is needed
+	capabilities, err := myProvider.GetCapabilities(context.Background(), &shard.GetCapabilitiesRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, shard.CurrentProtocolVersion, capabilities.ProtocolVersion)
+	assert.True(t, capabilities.HasFeature(shard.FeatureCheckConfig))
+	assert.True(t, capabilities.HasFeature(shard.FeatureCancelPull))
+	assert.True(t, capabilities.HasFeature(shard.FeatureIncremental))
+	assert.False(t, capabilities.HasFeature(shard.FeatureCheckCredentials))
+	assert.False(t, capabilities.HasFeature(shard.FeatureTableParameters))
+	assert.Equal(t, []shard.StorageType{shard.POSTGRESQL}, capabilities.StorageTypes)
+}
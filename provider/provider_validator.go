@@ -53,6 +53,43 @@ func (x *providerValidator) validate(ctx context.Context, myProvider *Provider,
 			// This is non-blocking, so that you try to detect all the errors at once, rather than squeezing them one by one
 			diagnostics.AddDiagnostics(table.Runtime().Validate(ctx, clientMeta, nil, table))
 		}
+		diagnostics.AddDiagnostics(x.validateTableDependencies())
+	}
+
+	return diagnostics
+}
+
+// The tables depended on must exist, only the root tables can have dependencies, and the root tables can not depend on each other
+func (x *providerValidator) validateTableDependencies() *schema.Diagnostics {
+
+	diagnostics := schema.NewDiagnostics()
+
+	tableSet := make(map[string]struct{})
+	var walk func(table *schema.Table, isRoot bool)
+	walk = func(table *schema.Table, isRoot bool) {
+		tableSet[table.TableName] = struct{}{}
+		if !isRoot && len(table.GetDependsOnTables()) != 0 {
+			diagnostics.AddErrorMsg(x.buildErrorMsg("table %s is a sub table, only the root table can depend on other tables", table.TableName))
+		}
+		for _, subTable := range table.SubTables {
+			walk(subTable, false)
+		}
+	}
+	for _, table := range x.myProvider.TableList {
+		walk(table, true)
+	}
+
+	for _, table := range x.myProvider.TableList {
+		for _, dependsOnTableName := range table.GetDependsOnTables() {
+			if _, exists := tableSet[dependsOnTableName]; !exists {
+				diagnostics.AddErrorMsg(x.buildErrorMsg("table %s depends on table %s, but it not exists", table.TableName, dependsOnTableName))
+			}
+		}
+	}
+
+	// also a root table can not depend on its own sub tables
+	if _, err := schema.SortTablesByDependencies(x.myProvider.TableList); err != nil {
+		diagnostics.AddErrorMsg(x.buildErrorMsg("%s", err.Error()))
 	}
 
 	return diagnostics
@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-provider-sdk/provider/transformer/column_value_extractor"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_providerValidator_validateTableDependencies(t *testing.T) {
+
+	newTable := func(tableName string, dependsOnTables []string, subTables ...*schema.Table) *schema.Table {
+		return &schema.Table{
+			TableName: tableName,
+			Columns: []*schema.Column{
+				{
+					ColumnName: "name",
+					Type:       schema.ColumnTypeString,
+					Extractor:  column_value_extractor.StructSelector("Name"),
+				},
+			},
+			Options:   &schema.TableOptions{DependsOnTables: dependsOnTables},
+			SubTables: subTables,
+		}
+	}
+	validate := func(tables ...*schema.Table) *schema.Diagnostics {
+		validator := &providerValidator{myProvider: &Provider{Name: "test-provider", Version: "v0.1", TableList: tables}}
+		return validator.validateTableDependencies()
+	}
+
+	d := validate(newTable("test_users", nil, newTable("test_user_keys", nil)), newTable("test_policies", []string{"test_user_keys"}))
+	assert.False(t, d.HasError(), d.ToString())
+
+	d = validate(newTable("test_users", []string{"test_policies"}), newTable("test_policies", []string{"test_users"}))
+	assert.Contains(t, d.ToString(), "tables depend on each other: test_users -> test_policies -> test_users")
+
+	d = validate(newTable("test_users", []string{"test_not_exists"}, newTable("test_user_keys", []string{"test_users"})))
+	assert.Contains(t, d.ToString(), "table test_users depends on table test_not_exists, but it not exists")
+	assert.Contains(t, d.ToString(), "table test_user_keys is a sub table, only the root table can depend on other tables")
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+)
+
+// runningPull A pull that has been started and not ended yet
+type runningPull struct {
+	pullId    string
+	beginTime time.Time
+
+	// Cancel the context shared by all tasks of the pull
+	cancelFunc context.CancelFunc
+
+	executor *schema.DataSourceExecutor
+
+	tableCount uint64
+
+	// The tables finished so far, it is also read and written by the PullTables, always hold the lock when access it
+	finishTableLock sync.RWMutex
+	finishTable     map[string]bool
+
+	lock sync.Mutex
+	// <rootTableName, cancel func of the context shared by the tasks of the root table>
+	tableCancelFuncMap map[string]context.CancelFunc
+	cancelledTableSet  map[string]bool
+}
+
+func newRunningPull(pullId string, cancelFunc context.CancelFunc, executor *schema.DataSourceExecutor, tableCount uint64) *runningPull {
+	return &runningPull{
+		pullId:             pullId,
+		beginTime:          time.Now(),
+		cancelFunc:         cancelFunc,
+		executor:           executor,
+		tableCount:         tableCount,
+		finishTable:        make(map[string]bool),
+		tableCancelFuncMap: make(map[string]context.CancelFunc),
+		cancelledTableSet:  make(map[string]bool),
+	}
+}
+
+// Derive the context of a root table from the pull context, so that the root table and its sub tables can be cancelled alone
+func (x *runningPull) buildTableContext(pullCtx context.Context, rootTableName string) context.Context {
+	tableCtx, cancelFunc := context.WithCancel(pullCtx)
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.tableCancelFuncMap[rootTableName] = cancelFunc
+	return tableCtx
+}
+
+func (x *runningPull) cancel() {
+	x.cancelFunc()
+}
+
+func (x *runningPull) cancelTable(tableName string) *schema.Diagnostics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	cancelFunc, exists := x.tableCancelFuncMap[tableName]
+	if !exists {
+		return schema.NewDiagnostics().AddErrorMsg("pull %s has no running root table %s, only the root table can be cancelled", x.pullId, tableName)
+	}
+	cancelFunc()
+	x.cancelledTableSet[tableName] = true
+	return nil
+}
+
+// The root tables cancelled by the host, sorted by name
+func (x *runningPull) getCancelledTables() []string {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	cancelledTables := make([]string, 0, len(x.cancelledTableSet))
+	for tableName := range x.cancelledTableSet {
+		cancelledTables = append(cancelledTables, tableName)
+	}
+	sort.Strings(cancelledTables)
+	return cancelledTables
+}
+
+// Release the contexts of the root tables when the pull is over
+func (x *runningPull) releaseTableContexts() {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for _, cancelFunc := range x.tableCancelFuncMap {
+		cancelFunc()
+	}
+}
+
+func (x *runningPull) status() *shard.GetPullStatusResponse {
+	queue := x.executor.TaskQueue()
+	runningTaskCount := make(map[string]uint64)
+	for tableName, count := range queue.RunningTaskCountMap() {
+		runningTaskCount[tableName] = uint64(count)
+	}
+
+	x.finishTableLock.RLock()
+	finishedTables := make(map[string]bool, len(x.finishTable))
+	for tableName, isFinished := range x.finishTable {
+		finishedTables[tableName] = isFinished
+	}
+	x.finishTableLock.RUnlock()
+
+	return &shard.GetPullStatusResponse{
+		PullId:              x.pullId,
+		ElapsedTime:         time.Since(x.beginTime),
+		QueuedTaskCount:     uint64(queue.QueuedTaskCount()),
+		UnfinishedTaskCount: uint64(queue.UnfinishedTaskCount()),
+		RunningTaskCount:    runningTaskCount,
+		FinishedTables:      finishedTables,
+		TableCount:          x.tableCount,
+		CancelledTables:     x.getCancelledTables(),
+	}
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// pullRegistry The running pulls of a provider, <pullId, runningPull>, so the host can check or cancel a pull while its stream is running
+type pullRegistry struct {
+	lock    sync.RWMutex
+	pullMap map[string]*runningPull
+}
+
+func newPullRegistry() *pullRegistry {
+	return &pullRegistry{
+		pullMap: make(map[string]*runningPull),
+	}
+}
+
+func (x *pullRegistry) register(pull *runningPull) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.pullMap[pull.pullId] = pull
+}
+
+func (x *pullRegistry) unregister(pullId string) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	delete(x.pullMap, pullId)
+}
+
+// Get the running pull, an error if the pull does not exist or is already over
+func (x *pullRegistry) get(pullId string) (*runningPull, *schema.Diagnostics) {
+	x.lock.RLock()
+	defer x.lock.RUnlock()
+
+	pull, exists := x.pullMap[pullId]
+	if !exists {
+		return nil, schema.NewDiagnostics().AddErrorMsg("pull %s is not running, it may be finished or never started", pullId)
+	}
+	return pull, nil
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// The pull id is stamped on every message of the pull
+type pullIdSender struct {
+	pullId string
+	sender shard.ProviderServerSender
+}
+
+var _ shard.ProviderServerSender = &pullIdSender{}
+
+func (x *pullIdSender) Send(response *shard.PullTablesResponse) error {
+	if response != nil {
+		response.PullId = x.pullId
+	}
+	return x.sender.Send(response)
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-utils/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPullIdSender struct {
+	lock          sync.Mutex
+	responses     []*shard.PullTablesResponse
+	pullIdChannel chan string
+}
+
+func (x *testPullIdSender) Send(response *shard.PullTablesResponse) error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if len(x.responses) == 0 {
+		x.pullIdChannel <- response.PullId
+	}
+	x.responses = append(x.responses, response)
+	return nil
+}
+
+func TestProvider_CancelTableAndPull(t *testing.T) {
+
+	blockingPull := func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, resultChannel chan<- any) *schema.Diagnostics {
+		<-ctx.Done()
+		return nil
+	}
+	tableA := &schema.Table{
+		TableName:  "test_cancel_table_a",
+		Columns:    []*schema.Column{{ColumnName: "name", Type: schema.ColumnTypeString}},
+		DataSource: schema.DataSource{Pull: blockingPull},
+	}
+	tableB := &schema.Table{
+		TableName:  "test_cancel_table_b",
+		Columns:    []*schema.Column{{ColumnName: "name", Type: schema.ColumnTypeString}},
+		DataSource: schema.DataSource{Pull: blockingPull},
+	}
+	provider := Provider{
+		Name:       "test-provider",
+		Version:    "v0.1",
+		TableList:  []*schema.Table{tableA, tableB},
+		DryRunSink: NewMemoryRowSink(),
+	}
+	initResponse, err := provider.Init(context.Background(), &shard.ProviderInitRequest{
+		Workspace:     pointer.ToStringPointer(t.TempDir()),
+		IsInstallInit: pointer.FalsePointer(),
+	})
+	assert.Nil(t, err)
+	assert.False(t, initResponse.Diagnostics.HasError(), initResponse.Diagnostics.ToString())
+
+	sender := &testPullIdSender{pullIdChannel: make(chan string, 1)}
+	pullDone := make(chan struct{})
+	go func() {
+		defer close(pullDone)
+		assert.Nil(t, provider.PullTables(context.Background(), &shard.PullTablesRequest{Tables: []string{"*"}, MaxGoroutines: 2, DryRun: true}, sender))
+	}()
+	pullId := <-sender.pullIdChannel
+	assert.NotEmpty(t, pullId)
+
+	// wait both tables are running
+	assert.Eventually(t, func() bool {
+		status, err := provider.GetPullStatus(context.Background(), &shard.GetPullStatusRequest{PullId: pullId})
+		return err == nil && status.RunningTaskCount[tableA.TableName] == 1 && status.RunningTaskCount[tableB.TableName] == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	// only the root table can be cancelled
+	cancelTableResponse, err := provider.CancelTable(context.Background(), &shard.CancelTableRequest{PullId: pullId, Table: "not_exists_table"})
+	assert.Nil(t, err)
+	assert.True(t, cancelTableResponse.Diagnostics.HasError())
+
+	cancelTableResponse, err = provider.CancelTable(context.Background(), &shard.CancelTableRequest{PullId: pullId, Table: tableA.TableName})
+	assert.Nil(t, err)
+	assert.Nil(t, cancelTableResponse.Diagnostics)
+	assert.Eventually(t, func() bool {
+		status, _ := provider.GetPullStatus(context.Background(), &shard.GetPullStatusRequest{PullId: pullId})
+		return status.RunningTaskCount[tableA.TableName] == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	// the other table keeps running until the whole pull is cancelled
+	status, err := provider.GetPullStatus(context.Background(), &shard.GetPullStatusRequest{PullId: pullId})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(status.RunningTaskCount))
+	assert.Equal(t, []string{tableA.TableName}, status.CancelledTables)
+	assert.Equal(t, uint64(2), status.TableCount)
+	assert.Greater(t, status.ElapsedTime, time.Duration(0))
+
+	cancelPullResponse, err := provider.CancelPull(context.Background(), &shard.CancelPullRequest{PullId: pullId})
+	assert.Nil(t, err)
+	assert.Nil(t, cancelPullResponse.Diagnostics)
+	select {
+	case <-pullDone:
+	case <-time.After(time.Second * 5):
+		t.Fatal("the pull is not stopped after cancelled")
+	}
+
+	// every message carries the pull id, and the summary tells both tables are cut off
+	sender.lock.Lock()
+	defer sender.lock.Unlock()
+	for _, response := range sender.responses {
+		assert.Equal(t, pullId, response.PullId)
+	}
+	summary := sender.responses[len(sender.responses)-1]
+	assert.True(t, summary.Diagnostics.HasError())
+	assert.True(t, summary.TableStatistics[tableA.TableName].IsCutOff)
+	assert.True(t, summary.TableStatistics[tableB.TableName].IsCutOff)
+
+	// the pull is not running anymore
+	status, err = provider.GetPullStatus(context.Background(), &shard.GetPullStatusRequest{PullId: pullId})
+	assert.Nil(t, err)
+	assert.True(t, status.Diagnostics.HasError())
+}
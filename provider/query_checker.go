@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Used to check whether a sql is a read-only query, the storage is shared by the provider, so the host tooling is only allowed to read it
+
+// These keywords may modify the storage or the session, reject them wherever they appear
+var queryForbiddenKeywordSet = map[string]struct{}{
+	"INSERT": {}, "UPDATE": {}, "DELETE": {}, "MERGE": {}, "UPSERT": {}, "REPLACE": {},
+	"CREATE": {}, "ALTER": {}, "DROP": {}, "TRUNCATE": {}, "RENAME": {}, "COMMENT": {},
+	"GRANT": {}, "REVOKE": {}, "COPY": {}, "INTO": {}, "LOCK": {},
+	"VACUUM": {}, "REINDEX": {}, "CLUSTER": {}, "REFRESH": {},
+	"CALL": {}, "DO": {}, "EXECUTE": {}, "PREPARE": {}, "DEALLOCATE": {},
+	"SET": {}, "RESET": {}, "DISCARD": {}, "LISTEN": {}, "NOTIFY": {}, "UNLISTEN": {},
+	"BEGIN": {}, "COMMIT": {}, "ROLLBACK": {}, "SAVEPOINT": {},
+}
+
+// These functions have side effects, although they can be called in a SELECT
+var queryForbiddenFunctionSet = map[string]struct{}{
+	"SET_CONFIG": {}, "NEXTVAL": {}, "SETVAL": {},
+	"PG_TERMINATE_BACKEND": {}, "PG_CANCEL_BACKEND": {}, "PG_RELOAD_CONF": {}, "PG_ROTATE_LOGFILE": {},
+	"PG_READ_FILE": {}, "PG_READ_BINARY_FILE": {}, "PG_LS_DIR": {}, "PG_STAT_FILE": {},
+	"LO_IMPORT": {}, "LO_EXPORT": {}, "LO_UNLINK": {},
+	"DBLINK": {}, "DBLINK_EXEC": {}, "PG_SLEEP": {},
+	"PG_ADVISORY_LOCK": {}, "PG_ADVISORY_XACT_LOCK": {}, "PG_TRY_ADVISORY_LOCK": {},
+}
+
+// The row lock clause, SELECT ... FOR UPDATE / FOR SHARE will lock the rows
+var queryRowLockKeywordSet = map[string]struct{}{
+	"UPDATE": {}, "SHARE": {}, "NO": {}, "KEY": {},
+}
+
+type queryToken struct {
+	// keyword or identifier in upper case, or a single punctuation
+	value string
+	// Whether this token is a word, quoted identifier and string literal are not word
+	isWord bool
+}
+
+// checkReadOnlyQuery Only a single SELECT (or WITH ... SELECT) statement is allowed
+func checkReadOnlyQuery(query string) error {
+
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return err
+	}
+
+	// drop the trailing semicolon
+	for len(tokens) > 0 && tokens[len(tokens)-1].value == ";" {
+		tokens = tokens[:len(tokens)-1]
+	}
+	if len(tokens) == 0 {
+		return errors.New("query is empty")
+	}
+
+	if first := tokens[0]; !first.isWord || (first.value != "SELECT" && first.value != "WITH") {
+		return fmt.Errorf("only SELECT statement is allowed, but got %s", tokens[0].value)
+	}
+
+	for index, token := range tokens {
+		if token.value == ";" {
+			return errors.New("only one statement is allowed")
+		}
+		if !token.isWord {
+			continue
+		}
+		if _, exists := queryForbiddenKeywordSet[token.value]; exists {
+			return fmt.Errorf("keyword %s is not allowed in read-only query", token.value)
+		}
+		if _, exists := queryForbiddenFunctionSet[token.value]; exists && index+1 < len(tokens) && tokens[index+1].value == "(" {
+			return fmt.Errorf("function %s is not allowed in read-only query", strings.ToLower(token.value))
+		}
+		if token.value == "FOR" && index+1 < len(tokens) && tokens[index+1].isWord {
+			if _, exists := queryRowLockKeywordSet[tokens[index+1].value]; exists {
+				return errors.New("row lock clause is not allowed in read-only query")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Split the query into tokens, skip comments, string literals and quoted identifiers, so that the keywords in them is not matched
+func tokenizeQuery(query string) ([]*queryToken, error) {
+	tokens := make([]*queryToken, 0)
+	runes := []rune(query)
+	for index := 0; index < len(runes); {
+		r := runes[index]
+		switch {
+		case unicode.IsSpace(r):
+			index++
+		case r == '-' && index+1 < len(runes) && runes[index+1] == '-':
+			// line comment
+			for index < len(runes) && runes[index] != '\n' {
+				index++
+			}
+		case r == '/' && index+1 < len(runes) && runes[index+1] == '*':
+			end := strings.Index(string(runes[index+2:]), "*/")
+			if end < 0 {
+				return nil, errors.New("unterminated block comment")
+			}
+			index += 2 + len([]rune(string(runes[index+2:])[:end])) + 2
+		case r == '\'' || r == '"':
+			// string literal or quoted identifier, the quote is escaped by doubling it,
+			// in an escape string E'...' the backslash escapes the next character too
+			quote := r
+			isEscapeString := quote == '\'' && isEscapeStringPrefix(runes, index, tokens)
+			index++
+			closed := false
+			for index < len(runes) {
+				if isEscapeString && runes[index] == '\\' {
+					index += 2
+					continue
+				}
+				if runes[index] == quote {
+					if index+1 < len(runes) && runes[index+1] == quote {
+						index += 2
+						continue
+					}
+					index++
+					closed = true
+					break
+				}
+				index++
+			}
+			if !closed {
+				return nil, errors.New("unterminated quoted string")
+			}
+			tokens = append(tokens, &queryToken{value: string(quote)})
+		case r == '$' && isDollarQuoteStart(runes, index):
+			// dollar quoted string, $tag$ ... $tag$
+			tagEnd := index + 1
+			for runes[tagEnd] != '$' {
+				tagEnd++
+			}
+			tag := string(runes[index : tagEnd+1])
+			end := strings.Index(string(runes[tagEnd+1:]), tag)
+			if end < 0 {
+				return nil, errors.New("unterminated dollar quoted string")
+			}
+			index = tagEnd + 1 + len([]rune(string(runes[tagEnd+1:])[:end])) + len([]rune(tag))
+			tokens = append(tokens, &queryToken{value: "$"})
+		case unicode.IsLetter(r) || r == '_':
+			begin := index
+			for index < len(runes) && (unicode.IsLetter(runes[index]) || unicode.IsDigit(runes[index]) || runes[index] == '_' || runes[index] == '$') {
+				index++
+			}
+			tokens = append(tokens, &queryToken{value: strings.ToUpper(string(runes[begin:index])), isWord: true})
+		default:
+			tokens = append(tokens, &queryToken{value: string(r)})
+			index++
+		}
+	}
+	return tokens, nil
+}
+
+// The E or e right before the quote is a prefix of an escape string, not the end of a longer word
+func isEscapeStringPrefix(runes []rune, index int, tokens []*queryToken) bool {
+	if index == 0 || (runes[index-1] != 'E' && runes[index-1] != 'e') || len(tokens) == 0 {
+		return false
+	}
+	lastToken := tokens[len(tokens)-1]
+	return lastToken.isWord && lastToken.value == "E"
+}
+
+// $$ or $tag$, but not a positional parameter like $1
+func isDollarQuoteStart(runes []rune, index int) bool {
+	for next := index + 1; next < len(runes); next++ {
+		r := runes[next]
+		if r == '$' {
+			return true
+		}
+		if next == index+1 && unicode.IsDigit(r) {
+			return false
+		}
+		if !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_') {
+			return false
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_checkReadOnlyQuery(t *testing.T) {
+
+	allowQueries := []string{
+		"SELECT * FROM aws_s3_buckets",
+		"select name, region from aws_s3_buckets where name = 'drop table foo; delete' limit 10;",
+		"WITH t AS (SELECT 1) SELECT * FROM t",
+		`SELECT "update" FROM "insert" -- delete everything`,
+		"SELECT $$ drop table $$, $tag$ ; $tag$ FROM t WHERE id = $1",
+		"SELECT /* truncate */ count(*) FROM t",
+		`SELECT E'it\'s', e'\\' FROM t`,
+		`SELECT '\' FROM t`,
+	}
+	for _, query := range allowQueries {
+		assert.Nil(t, checkReadOnlyQuery(query), query)
+	}
+
+	rejectQueries := []string{
+		"",
+		";",
+		"DELETE FROM aws_s3_buckets",
+		"SELECT 1; DROP TABLE aws_s3_buckets",
+		"SELECT * INTO backup FROM aws_s3_buckets",
+		"WITH d AS (DELETE FROM t RETURNING *) SELECT * FROM d",
+		"SELECT * FROM t FOR UPDATE",
+		"SELECT * FROM t FOR SHARE",
+		"SELECT set_config('a', 'b', false)",
+		"SELECT pg_sleep(100)",
+		"SELECT 'unterminated",
+		"SELECT 1 /* unterminated",
+		"EXPLAIN ANALYZE SELECT 1",
+		// the backslash escapes the quote in an escape string, the function is not in the string
+		`SELECT E'\'' , setval('s', 1) --'`,
+		`SELECT e'\\\'' , nextval('s') --'`,
+		// the backslash does not escape in a standard string
+		`SELECT '\' , setval('s', 1) --'`,
+	}
+	for _, query := range rejectQueries {
+		assert.NotNil(t, checkReadOnlyQuery(query), query)
+	}
+}
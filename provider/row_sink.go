@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+)
+
+// RowSink Where the transformed rows go, the storage.Storage is the sink of a normal pull,
+// a dry-run pull sends the rows to another sink so that no database is needed
+type RowSink interface {
+	Insert(ctx context.Context, table *schema.Table, rows *schema.Rows) *schema.Diagnostics
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// JsonRowSink Write each row as a line of JSON, like {"table": "table_name", "row": {"column_name": "value"}}
+type JsonRowSink struct {
+	lock   sync.Mutex
+	writer io.Writer
+	closer io.Closer
+}
+
+var _ RowSink = &JsonRowSink{}
+
+func NewJsonRowSink(writer io.Writer) *JsonRowSink {
+	return &JsonRowSink{
+		writer: writer,
+	}
+}
+
+// NewStdoutJsonRowSink Print the rows to stdout, convenient when run the provider locally
+func NewStdoutJsonRowSink() *JsonRowSink {
+	return NewJsonRowSink(os.Stdout)
+}
+
+// NewFileJsonRowSink Write the rows to the file, the file and its directory are created if not exists, the file is truncated if exists
+func NewFileJsonRowSink(path string) (*JsonRowSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	sink := NewJsonRowSink(file)
+	sink.closer = file
+	return sink, nil
+}
+
+type jsonRowSinkLine struct {
+	Table string         `json:"table"`
+	Row   map[string]any `json:"row"`
+}
+
+func (x *JsonRowSink) Insert(ctx context.Context, table *schema.Table, rows *schema.Rows) *schema.Diagnostics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for _, row := range rows.SplitRowByRow() {
+		line := jsonRowSinkLine{
+			Table: table.TableName,
+			Row:   make(map[string]any, row.ColumnCount()),
+		}
+		values := row.GetValues()
+		for index, columnName := range row.GetColumnNames() {
+			line.Row[columnName] = values[index]
+		}
+		marshal, err := json.Marshal(line)
+		if err != nil {
+			return schema.NewDiagnostics().AddErrorMsg("table %s row marshal to json error: %s", table.TableName, err.Error())
+		}
+		if _, err := x.writer.Write(append(marshal, '\n')); err != nil {
+			return schema.NewDiagnostics().AddErrorMsg("table %s row write error: %s", table.TableName, err.Error())
+		}
+	}
+	return nil
+}
+
+// Close Close the file if the sink is created by NewFileJsonRowSink
+func (x *JsonRowSink) Close() error {
+	if x.closer == nil {
+		return nil
+	}
+	return x.closer.Close()
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// MemoryRowSink Collect the rows in memory, usually used in tests
+type MemoryRowSink struct {
+	lock sync.Mutex
+
+	// <tableName, rows>
+	rowsMap map[string][]*schema.Row
+}
+
+var _ RowSink = &MemoryRowSink{}
+
+func NewMemoryRowSink() *MemoryRowSink {
+	return &MemoryRowSink{
+		rowsMap: make(map[string][]*schema.Row),
+	}
+}
+
+func (x *MemoryRowSink) Insert(ctx context.Context, table *schema.Table, rows *schema.Rows) *schema.Diagnostics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.rowsMap[table.TableName] = append(x.rowsMap[table.TableName], rows.SplitRowByRow()...)
+	return nil
+}
+
+// GetRows The rows collected of the table
+func (x *MemoryRowSink) GetRows(tableName string) []*schema.Row {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	rows := make([]*schema.Row, len(x.rowsMap[tableName]))
+	copy(rows, x.rowsMap[tableName])
+	return rows
+}
+
+// GetTableNames The tables which have rows collected
+func (x *MemoryRowSink) GetTableNames() []string {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	tableNames := make([]string, 0, len(x.rowsMap))
+	for tableName := range x.rowsMap {
+		tableNames = append(tableNames, tableName)
+	}
+	return tableNames
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-provider-sdk/provider/transformer/column_value_extractor"
+	"github.com/selefra/selefra-utils/pkg/pointer"
+	"github.com/stretchr/testify/assert"
+)
+
+type testPullTablesSender struct {
+	responses []*shard.PullTablesResponse
+}
+
+func (x *testPullTablesSender) Send(response *shard.PullTablesResponse) error {
+	x.responses = append(x.responses, response)
+	return nil
+}
+
+func TestProviderRuntime_PullTablesDryRun(t *testing.T) {
+
+	type Parent struct {
+		Name string
+	}
+	type Child struct {
+		Index int
+	}
+
+	childTable := &schema.Table{
+		TableName: "test_dry_run_child",
+		Columns: []*schema.Column{
+			{
+				ColumnName: "parent_name",
+				Type:       schema.ColumnTypeString,
+				Extractor:  column_value_extractor.ParentColumnValue("name"),
+			},
+			{
+				ColumnName: "index",
+				Type:       schema.ColumnTypeInt,
+				Extractor:  column_value_extractor.StructSelector("Index"),
+			},
+		},
+		DataSource: schema.DataSource{
+			Pull: func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, resultChannel chan<- any) *schema.Diagnostics {
+				resultChannel <- []*Child{{Index: 1}, {Index: 2}}
+				return nil
+			},
+		},
+	}
+	parentTable := &schema.Table{
+		TableName: "test_dry_run_parent",
+		Columns: []*schema.Column{
+			{
+				ColumnName: "name",
+				Type:       schema.ColumnTypeString,
+				Extractor:  column_value_extractor.StructSelector("Name"),
+			},
+		},
+		SubTables: []*schema.Table{childTable},
+		DataSource: schema.DataSource{
+			Pull: func(ctx context.Context, clientMeta *schema.ClientMeta, client any, task *schema.DataSourcePullTask, resultChannel chan<- any) *schema.Diagnostics {
+				resultChannel <- []*Parent{{Name: "a"}, {Name: "b"}}
+				return nil
+			},
+		},
+	}
+
+	sink := NewMemoryRowSink()
+	traceBuff := &bytes.Buffer{}
+	provider := Provider{
+		Name:      "test-provider",
+		Version:   "v0.1",
+		TableList: []*schema.Table{parentTable},
+		TransformerMeta: schema.TransformerMeta{
+			DataSourcePullResultAutoExpand: true,
+		},
+		DryRunSink:   sink,
+		SpanExporter: schema.NewJsonSpanExporter(traceBuff),
+	}
+
+	// no storage is given
+	initResponse, err := provider.Init(context.Background(), &shard.ProviderInitRequest{
+		Workspace:     pointer.ToStringPointer(t.TempDir()),
+		IsInstallInit: pointer.FalsePointer(),
+	})
+	assert.Nil(t, err)
+	assert.False(t, initResponse.Diagnostics.HasError(), initResponse.Diagnostics.ToString())
+
+	// a normal pull needs the storage
+	sender := &testPullTablesSender{}
+	assert.Nil(t, provider.PullTables(context.Background(), &shard.PullTablesRequest{Tables: []string{"*"}}, sender))
+	assert.True(t, sender.responses[len(sender.responses)-1].Diagnostics.HasError())
+
+	sender = &testPullTablesSender{}
+	assert.Nil(t, provider.PullTables(context.Background(), &shard.PullTablesRequest{Tables: []string{"*"}, DryRun: true}, sender))
+	for _, response := range sender.responses {
+		if response.Diagnostics != nil {
+			assert.False(t, response.Diagnostics.HasError(), response.Diagnostics.ToString())
+		}
+	}
+
+	assert.Len(t, sink.GetRows(parentTable.TableName), 2)
+	// the sub table expands on each parent row
+	childRows := sink.GetRows(childTable.TableName)
+	assert.Len(t, childRows, 4)
+	parentNameCount := make(map[string]int)
+	for _, row := range childRows {
+		parentNameCount[row.GetStringOrDefault("parent_name", "")]++
+	}
+	assert.Equal(t, map[string]int{"a": 2, "b": 2}, parentNameCount)
+
+	// the last message is the summary
+	summary := sender.responses[len(sender.responses)-1].TableStatistics
+	assert.Equal(t, int64(1), summary[parentTable.TableName].TaskCount)
+	assert.Equal(t, int64(2), summary[parentTable.TableName].RowCount)
+	assert.Equal(t, int64(2), summary[childTable.TableName].TaskCount)
+	assert.Equal(t, int64(2), summary[childTable.TableName].RawResultCount)
+	assert.Equal(t, int64(4), summary[childTable.TableName].RowCount)
+	assert.False(t, summary[childTable.TableName].IsCutOff)
+
+	// the row of each table is transformed and inserted in its own span
+	spanNameCount := make(map[string]int)
+	for _, line := range bytes.Split(bytes.TrimSpace(traceBuff.Bytes()), []byte("\n")) {
+		span := make(map[string]any)
+		assert.Nil(t, json.Unmarshal(line, &span))
+		spanNameCount[span["name"].(string)]++
+	}
+	assert.Equal(t, 1, spanNameCount["pull_tables"])
+	assert.Equal(t, 6, spanNameCount["transform"])
+	assert.Equal(t, 6, spanNameCount["insert"])
+}
+
+func TestJsonRowSink_Insert(t *testing.T) {
+	buff := &bytes.Buffer{}
+	sink := NewJsonRowSink(buff)
+	rows := schema.NewRows("name", "count")
+	assert.Nil(t, rows.AppendRowValues([]any{"a", 1}))
+	assert.Nil(t, rows.AppendRowValues([]any{"b", 2}))
+	assert.Nil(t, sink.Insert(context.Background(), &schema.Table{TableName: "test_table"}, rows))
+
+	lines := bytes.Split(bytes.TrimSpace(buff.Bytes()), []byte("\n"))
+	assert.Len(t, lines, 2)
+	line := make(map[string]any)
+	assert.Nil(t, json.Unmarshal(lines[1], &line))
+	assert.Equal(t, "test_table", line["table"])
+	assert.Equal(t, map[string]any{"name": "b", "count": float64(2)}, line["row"])
+}
@@ -0,0 +1,126 @@
+package schema
+
+import (
+	"sort"
+	"sync"
+)
+
+// LabeledClient The client can implement this interface to describe itself, for example the account id and region,
+// the labels are attached to the diagnostics and the statistics about the client, so the user knows which client goes wrong
+type LabeledClient interface {
+	ClientLabels() map[string]string
+}
+
+// GetClientLabels The labels of the client, nil if the client does not implement LabeledClient
+func GetClientLabels(client any) map[string]string {
+	labeledClient, ok := client.(LabeledClient)
+	if !ok {
+		return nil
+	}
+	labels := labeledClient.ClientLabels()
+	if len(labels) == 0 {
+		return nil
+	}
+	labelsCopy := make(map[string]string, len(labels))
+	for key, value := range labels {
+		labelsCopy[key] = value
+	}
+	return labelsCopy
+}
+
+// DefaultClientAuthFailureThreshold How many auth failures in a row open the circuit of a client by default
+const DefaultClientAuthFailureThreshold = 3
+
+// ClientCircuitBreaker Count the auth failures of each client, after several in a row the circuit of the client is open,
+// and its remaining tasks are skipped, the other clients are not affected
+type ClientCircuitBreaker struct {
+	// Less than or equal to 0 means never open the circuit
+	threshold int
+
+	clientMeta *ClientMeta
+
+	lock sync.Mutex
+	// <clientKey, auth failures in a row>
+	authFailureCountMap map[string]int
+	openClientSet       map[string]bool
+
+	// The types of the clients that fail auth but can not be told apart, only warn once for each type
+	indistinctClientTypeSet map[string]bool
+}
+
+func NewClientCircuitBreaker(threshold int) *ClientCircuitBreaker {
+	return &ClientCircuitBreaker{
+		threshold:           threshold,
+		authFailureCountMap: make(map[string]int),
+		openClientSet:       make(map[string]bool),
+
+		indistinctClientTypeSet: make(map[string]bool),
+	}
+}
+
+// Log the warnings by the client meta
+func (x *ClientCircuitBreaker) withClientMeta(clientMeta *ClientMeta) *ClientCircuitBreaker {
+	x.clientMeta = clientMeta
+	return x
+}
+
+// IsOpen Whether the tasks of the client should be skipped
+func (x *ClientCircuitBreaker) IsOpen(client any) bool {
+	if client == nil {
+		return false
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.openClientSet[getClientKey(client)]
+}
+
+// OpenClients The keys of the clients whose circuit is open, sorted
+func (x *ClientCircuitBreaker) OpenClients() []string {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	clientKeys := make([]string, 0, len(x.openClientSet))
+	for clientKey := range x.openClientSet {
+		clientKeys = append(clientKeys, clientKey)
+	}
+	sort.Strings(clientKeys)
+	return clientKeys
+}
+
+// Record the result of a pull of the client, any result without auth failure resets the count,
+// return true only when this result opens the circuit. The clients that can not be told apart are not counted,
+// otherwise one broken client would open the circuit of all the others
+func (x *ClientCircuitBreaker) record(client any, diagnostics *Diagnostics) bool {
+	if client == nil || x.threshold <= 0 {
+		return false
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	clientKey, isDistinct := getClientKeyE(client)
+	if !isDistinct {
+		if diagnostics.IsAuthFailed() && !x.indistinctClientTypeSet[clientKey] {
+			x.indistinctClientTypeSet[clientKey] = true
+			if x.clientMeta != nil {
+				x.clientMeta.WarnF("client %s failed auth but can not be told apart from the other clients, implement RateLimitClient to skip the broken one", clientKey)
+			}
+		}
+		return false
+	}
+	if x.openClientSet[clientKey] {
+		return false
+	}
+	if !diagnostics.IsAuthFailed() {
+		delete(x.authFailureCountMap, clientKey)
+		return false
+	}
+	x.authFailureCountMap[clientKey]++
+	if x.authFailureCountMap[clientKey] < x.threshold {
+		return false
+	}
+	x.openClientSet[clientKey] = true
+	return true
+}
@@ -0,0 +1,150 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+type testLabeledClient struct {
+	account string
+	region  string
+}
+
+func (x *testLabeledClient) RateLimitKey() string {
+	return x.account + "/" + x.region
+}
+
+func (x *testLabeledClient) ClientLabels() map[string]string {
+	return map[string]string{"account_id": x.account, "region": x.region}
+}
+
+func TestClientCircuitBreaker(t *testing.T) {
+
+	executor := newTestExecutor(t, 1)
+	executor.errorsHandlerMeta.ClientAuthFailureThreshold = 2
+	executor.circuitBreaker = NewClientCircuitBreaker(executor.errorsHandlerMeta.GetClientAuthFailureThreshold())
+	goodClient := &testLabeledClient{account: "good", region: "us-east-1"}
+	badClient := &testLabeledClient{account: "bad", region: "us-west-2"}
+	executor.clientMeta.runtime.client = []any{goodClient, badClient}
+
+	var goodPullCount, badPullCount int32
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	doneTables := make(map[string]bool)
+	doneTablesLock := sync.Mutex{}
+	for i := 0; i < 4; i++ {
+		executor.Submit(context.Background(), &DataSourcePullTask{
+			TaskId: id_util.RandomId(),
+			Ctx:    context.Background(),
+			Table: &Table{
+				TableName: fmt.Sprintf("test_circuit_breaker_table_%d", i),
+				DataSource: DataSource{
+					Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+						if client == badClient {
+							atomic.AddInt32(&badPullCount, 1)
+							return NewDiagnostics().AddError(NewAuthError(errors.New("invalid access key")))
+						}
+						atomic.AddInt32(&goodPullCount, 1)
+						return nil
+					},
+				},
+			},
+			DiagnosticsChannel: diagnosticsChannel,
+			TableDoneCallback: func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask, table *Table) *Diagnostics {
+				doneTablesLock.Lock()
+				defer doneTablesLock.Unlock()
+				doneTables[table.TableName] = true
+				return nil
+			},
+			IsRootTask: true,
+		})
+	}
+	executor.ShutdownAndAwaitTermination(context.Background())
+	close(diagnosticsChannel)
+
+	// the bad client is skipped after two auth failures, the good client is not affected
+	assert.Equal(t, int32(4), atomic.LoadInt32(&goodPullCount))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&badPullCount))
+	assert.Equal(t, []string{badClient.RateLimitKey()}, executor.CircuitBreaker().OpenClients())
+	assert.True(t, executor.CircuitBreaker().IsOpen(badClient))
+	assert.False(t, executor.CircuitBreaker().IsOpen(goodClient))
+
+	// the tables with skipped tasks are not done
+	assert.Len(t, doneTables, 2)
+
+	skippedTaskCount := int64(0)
+	for tableName, statistics := range executor.Statistics().Snapshot() {
+		skippedTaskCount += statistics.SkippedTaskCount
+		assert.Equal(t, doneTables[tableName], statistics.SkippedTaskCount == 0)
+		if clientStatistics, exists := statistics.ClientStatistics[badClient.RateLimitKey()]; exists {
+			assert.Equal(t, badClient.ClientLabels(), clientStatistics.ClientLabels)
+		}
+	}
+	assert.Equal(t, int64(2), skippedTaskCount)
+
+	// the errors are tagged with the client
+	authErrorCount, circuitOpenCount := 0, 0
+	for d := range diagnosticsChannel {
+		if d == nil {
+			continue
+		}
+		for _, diagnostic := range d.GetDiagnosticSlice() {
+			if diagnostic.Level() < DiagnosisLevelError {
+				continue
+			}
+			assert.Equal(t, badClient.RateLimitKey(), diagnostic.ClientKey())
+			assert.Equal(t, badClient.ClientLabels(), diagnostic.ClientLabels())
+			if diagnostic.ErrorClass() == ErrorClassAuth {
+				authErrorCount++
+			} else {
+				circuitOpenCount++
+			}
+		}
+	}
+	assert.Equal(t, 2, authErrorCount)
+	assert.Equal(t, 1, circuitOpenCount)
+}
+
+func TestClientCircuitBreaker_Reset(t *testing.T) {
+	client := &testLabeledClient{account: "a", region: "b"}
+	breaker := NewClientCircuitBreaker(2)
+	authFailed := NewDiagnostics().AddAuthErrorMsg("expired token")
+	assert.False(t, authFailed.IsRetryable())
+
+	// a result without auth failure resets the count
+	assert.False(t, breaker.record(client, authFailed))
+	assert.False(t, breaker.record(client, NewDiagnostics().AddErrorMsg("not found")))
+	assert.False(t, breaker.record(client, authFailed))
+	assert.False(t, breaker.IsOpen(client))
+	assert.True(t, breaker.record(client, authFailed))
+	assert.True(t, breaker.IsOpen(client))
+
+	// never open
+	breaker = NewClientCircuitBreaker(-1)
+	for i := 0; i < 10; i++ {
+		assert.False(t, breaker.record(client, authFailed))
+	}
+}
+
+func TestClientCircuitBreaker_ValueClient(t *testing.T) {
+	breaker := NewClientCircuitBreaker(1)
+	authFailed := NewDiagnostics().AddAuthErrorMsg("expired token")
+
+	// the value clients of the same type can not be told apart, one broken client must not skip the others
+	assert.False(t, breaker.record("client-a", authFailed))
+	assert.False(t, breaker.record("client-a", authFailed))
+	assert.False(t, breaker.IsOpen("client-a"))
+	assert.False(t, breaker.IsOpen("client-b"))
+	assert.Empty(t, breaker.OpenClients())
+
+	// the value client implements RateLimitClient can be told apart
+	assert.True(t, breaker.record(testStatisticsClient("client-a"), authFailed))
+	assert.True(t, breaker.IsOpen(testStatisticsClient("client-a")))
+	assert.False(t, breaker.IsOpen(testStatisticsClient("client-b")))
+}
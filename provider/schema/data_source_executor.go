@@ -3,14 +3,14 @@ package schema
 import (
 	"context"
 	"fmt"
-	"github.com/emirpasic/gods/lists/singlylinkedlist"
 	"github.com/selefra/selefra-utils/pkg/id_util"
 	"github.com/selefra/selefra-utils/pkg/reflect_util"
 	"github.com/selefra/selefra-utils/pkg/runtime_util"
-	"os"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -29,6 +29,40 @@ type DataSourceExecutor struct {
 
 	// Number of worker concurrency, the maximum number of workers working at the same time
 	workerNum uint64
+
+	// Throttle the data source pull when the memory is tight
+	memoryGovernor *MemoryGovernor
+
+	// The rate limits of all tasks
+	rateLimiter *RateLimiter
+
+	// Save the progress so that the pull can be resumed, nil means no checkpoint
+	checkpoint *PullCheckpoint
+
+	// Track the watermarks of the incremental tables, nil means the tables are always pulled in full
+	watermark *PullWatermark
+
+	// What happened to each table and client
+	statistics *PullStatistics
+
+	// Drop the duplicate rows of the tables with TableOptions.DeduplicateRows
+	rowDeduplicator *rowDeduplicator
+
+	// Know when a table is really done
+	completionTracker *tableCompletionTracker
+
+	// Skip the tasks of the clients with repeated auth failures
+	circuitBreaker *ClientCircuitBreaker
+
+	// Hold the root tasks until the tables they depend on are done
+	dependencyScheduler *tableDependencyScheduler
+
+	// Pull only a few results of each table, nil means pull everything
+	sampling *PullSampling
+
+	// <tableName, parameters>, the parameters passed by the host for the targeted refresh
+	tableParameters map[string]TableParameterValues
+
 	// Concurrency control wait
 	wg *sync.WaitGroup
 }
@@ -55,6 +89,14 @@ func NewDataSourcePullExecutor(workerNum uint64, clientMeta *ClientMeta, errorsH
 		workerNum:         workerNum,
 		taskQueue:         NewDataSourcePullTaskQueue(),
 		wg:                &sync.WaitGroup{},
+		memoryGovernor:    NewMemoryGovernor(0, clientMeta),
+		rateLimiter:       NewRateLimiter(),
+		statistics:        NewPullStatistics(),
+		rowDeduplicator:   newRowDeduplicator(clientMeta),
+		completionTracker: newTableCompletionTracker(),
+		circuitBreaker:    NewClientCircuitBreaker(errorsHandlerMeta.GetClientAuthFailureThreshold()).withClientMeta(clientMeta),
+
+		dependencyScheduler: newTableDependencyScheduler(),
 	}
 
 	// The worker pool is started when created
@@ -63,19 +105,118 @@ func NewDataSourcePullExecutor(workerNum uint64, clientMeta *ClientMeta, errorsH
 	return executor, diagnostics
 }
 
-// Submit Submit a data source pull task for execution
+// SetMemoryGovernor Use the given memory governor instead of the default one, it should be set before any task is submitted
+func (x *DataSourceExecutor) SetMemoryGovernor(memoryGovernor *MemoryGovernor) *DataSourceExecutor {
+	if memoryGovernor != nil {
+		x.memoryGovernor = memoryGovernor
+	}
+	return x
+}
+
+// RateLimiter The rate limiter shared by all tasks of this executor, it can tell how long each table is throttled
+func (x *DataSourceExecutor) RateLimiter() *RateLimiter {
+	return x.rateLimiter
+}
+
+// Statistics The statistics of all tasks of this executor, it can be read while the tasks are running
+func (x *DataSourceExecutor) Statistics() *PullStatistics {
+	return x.statistics
+}
+
+// CircuitBreaker Tell which clients are skipped because of repeated auth failures
+func (x *DataSourceExecutor) CircuitBreaker() *ClientCircuitBreaker {
+	return x.circuitBreaker
+}
+
+// TaskQueue The task queue of this executor, it can tell how many tasks are waiting or running
+func (x *DataSourceExecutor) TaskQueue() *DataSourcePullTaskQueue {
+	return x.taskQueue
+}
+
+// SetCheckpoint Track and save the progress of the tasks, it should be set before any task is submitted
+func (x *DataSourceExecutor) SetCheckpoint(checkpoint *PullCheckpoint) *DataSourceExecutor {
+	x.checkpoint = checkpoint
+	return x
+}
+
+// SetWatermark Track the watermarks of the incremental tables, it should be set before any task is submitted
+func (x *DataSourceExecutor) SetWatermark(watermark *PullWatermark) *DataSourceExecutor {
+	x.watermark = watermark
+	return x
+}
+
+// SetSampling Cap the results of each table and the child tasks of each parent, it should be set before any task is submitted
+func (x *DataSourceExecutor) SetSampling(sampling *PullSampling) *DataSourceExecutor {
+	x.sampling = sampling
+	return x
+}
+
+// SetTableParameters Pass the checked parameters to the tasks of the tables, <tableName, parameters>, it should be set before any task is submitted
+func (x *DataSourceExecutor) SetTableParameters(tableParameters map[string]TableParameterValues) *DataSourceExecutor {
+	x.tableParameters = tableParameters
+	return x
+}
+
+// Submit Submit a data source pull task for execution, the root task is held until the tables it depends on are done,
+// so the root tables should be submitted in the order of SortTablesByDependencies
 func (x *DataSourceExecutor) Submit(ctx context.Context, task *DataSourcePullTask) *Diagnostics {
 	x.clientMeta.DebugF("executorId = %s, taskId = %s, executor submit task", x.executorId, task.TaskId)
+	if task.rateLimiter == nil {
+		task.rateLimiter = x.rateLimiter
+	}
+	task.statistics = x.statistics
+	task.rowDeduplicator = x.rowDeduplicator
+	if x.checkpoint != nil {
+		task.checkpoint = x.checkpoint
+		x.checkpoint.taskSubmitted(task)
+	}
+	if x.watermark != nil {
+		task.watermark = x.watermark
+	}
+	if parameters, exists := x.tableParameters[task.Table.TableName]; exists {
+		task.parameters = parameters
+	}
+	x.completionTracker.taskSubmitted(task)
+	if x.dependencyScheduler.taskSubmitted(task) {
+		x.clientMeta.DebugF("executorId = %s, taskId = %s, table %s waits for the tables it depends on", x.executorId, task.TaskId, task.Table.TableName)
+		return nil
+	}
 	x.taskQueue.Add(task)
 	return nil
 }
 
+// The tables the root task depends on are settled, run it, but skip it if any of them is not done
+func (x *DataSourceExecutor) releaseWaitingTask(task *DataSourcePullTask) {
+	unfinishedTables := make([]string, 0)
+	for _, tableName := range x.dependencyScheduler.pulledDependencies(task.Table) {
+		if !x.completionTracker.isCompleted(tableName) {
+			unfinishedTables = append(unfinishedTables, tableName)
+		}
+	}
+	if len(unfinishedTables) != 0 {
+		x.clientMeta.WarnF("executorId = %s, taskId = %s, skip table %s, the tables it depends on are not done: %s", x.executorId, task.TaskId, task.Table.TableName, strings.Join(unfinishedTables, ", "))
+		task.isSkipped = true
+		x.statistics.update(task, func(counters *PullCounters) {
+			counters.SkippedTaskCount++
+		})
+		x.sendDiagnostics(task, NewDiagnostics().AddErrorMsg("table %s is skipped, because the tables it depends on are not done: %s", task.Table.TableName, strings.Join(unfinishedTables, ", ")))
+	}
+	x.taskQueue.Add(task)
+}
+
 // ShutdownAndAwaitTermination Close the task queue and hold the current coroutine until the task completes or times out
 func (x *DataSourceExecutor) ShutdownAndAwaitTermination(ctx context.Context) *Diagnostics {
 
 	x.clientMeta.DebugF("executorId = %s, executor shutdown and await termination", x.executorId)
+	// The workers exit when there is no unfinished task, including the child tasks which have not been submitted yet
+	x.taskQueue.Shutdown()
 	x.wg.Wait()
 
+	// The cursors may not be saved yet because of the save interval
+	if x.checkpoint != nil {
+		return x.checkpoint.Save()
+	}
+
 	return nil
 }
 
@@ -83,13 +224,10 @@ func (x *DataSourceExecutor) runWorkers() {
 
 	x.clientMeta.DebugF("executorId = %s, workerNum = %d, executor begin run worker", x.executorId, x.workerNum)
 
-	semaphore := NewConsumerSemaphore(x.clientMeta)
-
 	for i := uint64(1); i <= x.workerNum; i++ {
 		x.wg.Add(1)
 
 		consumerId := i
-		semaphore.Init(consumerId)
 
 		go func() {
 
@@ -98,30 +236,51 @@ func (x *DataSourceExecutor) runWorkers() {
 				x.clientMeta.DebugF("executorId = %s, consumerId = %d, executor consumer exit", x.executorId, consumerId)
 			}()
 
-			for !semaphore.IsAllConsumerDone() {
+			// Block until a task is available, nil means the queue is shutdown and all tasks are done
+			for task := x.taskQueue.WaitTake(); task != nil; task = x.taskQueue.WaitTake() {
 
-				if task := x.taskQueue.Take(); task != nil {
+				taskStartTime := time.Now()
+				x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, executor begin exec task", x.executorId, consumerId, task.TaskId)
 
-					semaphore.Running(consumerId)
+				diagnostics := x.execTaskWithRecovery(consumerId, task)
 
-					taskStartTime := time.Now()
-					x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, executor begin exec task", x.executorId, consumerId, task.TaskId)
+				execTaskCost := time.Now().Sub(taskStartTime)
+				resultMessage := ""
+				if diagnostics != nil {
+					resultMessage = diagnostics.String()
+				}
+				x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, executor exec task done, cost = %s, result message = %s", x.executorId, consumerId, task.logId(), execTaskCost.String(), resultMessage)
 
-					diagnostics := x.execTaskWithRecovery(consumerId, task)
+				// The task skipped because of its broken client is cut off too, it is not done
+				isCutOff := task.IsCancelled() || task.isSkipped
 
-					execTaskCost := time.Now().Sub(taskStartTime)
-					resultMessage := ""
-					if diagnostics != nil {
-						resultMessage = diagnostics.String()
-					}
-					x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, executor exec task done, cost = %s, result message = %s", x.executorId, consumerId, task.TaskId, execTaskCost.String(), resultMessage)
+				// The checkpoint must know the task is done before the queue, otherwise the pull may exit before the progress is saved
+				if x.checkpoint != nil {
+					// The sampled task has not pulled everything, it is pulled again when resumed
+					d := x.checkpoint.taskDone(task, isCutOff || task.isSampledOut)
+					x.clientMeta.LogDiagnostics(fmt.Sprintf("taskId = %s, checkpoint", task.logId()), d)
+				}
 
-					task.DiagnosticsChannel <- diagnostics
+				// A watermark is saved only if all the tasks of it run to the end without error, and the whole table is pulled
+				if (diagnostics != nil && diagnostics.HasError()) || isCutOff || task.isParameterized() {
+					x.watermarkTaskFailed(task)
+				}
 
-				} else {
-					semaphore.Idle(consumerId)
-					time.Sleep(time.Second * 3)
+				x.sendDiagnostics(task, diagnostics)
+
+				// The table done callback is also before the queue, so that all callbacks are called before the executor terminates
+				for _, table := range x.completionTracker.taskDone(task, isCutOff) {
+					x.execTableDoneCallback(task, table)
 				}
+
+				// The root tasks waiting for the tree of this task may run now, they are added before this task is done,
+				// so the queue is never empty while some tasks are waiting
+				for _, waitingTask := range x.dependencyScheduler.taskDone(task) {
+					x.releaseWaitingTask(waitingTask)
+				}
+
+				// The child tasks are submitted during exec, so when this task is done, the task tree is still counted correctly
+				x.taskQueue.Done(task)
 			}
 
 		}()
@@ -132,18 +291,65 @@ func (x *DataSourceExecutor) execTaskWithRecovery(consumerId uint64, task *DataS
 
 	diagnostics = NewDiagnostics()
 
+	// The span of the task is the child of the span of the task which submits it, so the spans follow the task tree
+	ctx, span := StartSpan(task.Context(), "task")
+	if span != nil {
+		task.Ctx = ctx
+		span.SetAttribute("task_id", task.TaskId).SetAttribute("table_name", task.Table.TableName).SetAttribute("is_root_task", task.IsRootTask)
+		if task.IsExpandDone {
+			span.SetAttribute("client", getClientKey(task.Client))
+		}
+	}
+	// Registered before the recover, so that it runs after the panic is recovered
+	defer func() {
+		if task.IsCancelled() {
+			span.SetAttribute("is_cancelled", true)
+		}
+		span.SetDiagnostics(diagnostics).End()
+	}()
+
 	defer func() {
 		if r := recover(); r != nil {
-			x.clientMeta.ErrorF("executorId = %s, consumerId = %d, taskId = %s, exec task panic, error msg = %v", x.executorId, consumerId, task.TaskId, r)
+			x.clientMeta.ErrorF("executorId = %s, consumerId = %d, taskId = %s, exec task panic, error msg = %v", x.executorId, consumerId, task.logId(), r)
 			diagnostics.AddErrorMsg("exec task panic, table = %s, msg = %s", task.Table.TableName, r)
 		}
 	}()
 
+	// The pull is cancelled or timeout, the task is dropped, it will be reported as not finished
+	if task.IsCancelled() {
+		x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, task is cancelled before exec, drop it", x.executorId, consumerId, task.logId())
+		return diagnostics
+	}
+
+	// The tables it depends on are not done, the task is skipped, it will be reported as not finished
+	if task.isSkipped {
+		x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, task is skipped before exec", x.executorId, consumerId, task.logId())
+		span.SetAttribute("is_skipped", true)
+		return diagnostics
+	}
+
+	// The client is broken, the task is skipped, it will be reported as not finished
+	if task.IsExpandDone && x.circuitBreaker.IsOpen(task.Client) {
+		x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, the circuit of client %s is open, skip it", x.executorId, consumerId, task.logId(), getClientKey(task.Client))
+		task.isSkipped = true
+		span.SetAttribute("is_skipped", true)
+		x.statistics.update(task, func(counters *PullCounters) {
+			counters.SkippedTaskCount++
+		})
+		return diagnostics
+	}
+
 	x.execTask(task)
 
+	// A task cut off halfway is not done, so do not callback
+	if task.IsCancelled() {
+		x.clientMeta.DebugF("executorId = %s, consumerId = %d, taskId = %s, task is cancelled during exec", x.executorId, consumerId, task.logId())
+		return diagnostics
+	}
+
 	// Callback method after task completion, if any
 	if task.TaskDoneCallback != nil {
-		diagnostics.AddDiagnostics(task.TaskDoneCallback(task.Ctx, x.clientMeta, task))
+		diagnostics.AddDiagnostics(task.TaskDoneCallback(task.Context(), x.clientMeta, task))
 	}
 
 	return diagnostics
@@ -158,7 +364,7 @@ func GetMemoryUsage() int {
 // Tasks may generate new tasks, which are executed recursively
 func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 
-	taskId := task.TaskId
+	taskId := task.logId()
 	table := task.Table
 	taskBegin := time.Now()
 	isIgnorePullTableError := x.errorsHandlerMeta.IsIgnore(IgnoredErrorOnPullTable)
@@ -170,14 +376,33 @@ func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 	// just init client task context if it is not
 	if !task.IsExpandDone {
 		x.clientMeta.DebugF("taskId = %s, IsExpandDone not ok", taskId)
-		x.expandTask(context.Background(), task)
+		expandCtx, expandSpan := StartSpan(task.Context(), "expand")
+		x.expandTask(expandCtx, task)
+		expandSpan.End()
 		return
 	}
 
-	resultChannel := make(chan any, 10000)
+	// Do not start a new pull until the memory falls back, the task is dropped if it is cancelled during waiting
+	memoryDiagnostics, err := x.memoryGovernor.WaitForPullStart(task.Context(), taskId)
+	if err != nil {
+		x.clientMeta.DebugF("taskId = %s, wait for memory error: %s", taskId, err.Error())
+		return
+	}
+	defer x.memoryGovernor.PullDone()
+	if memoryDiagnostics != nil {
+		x.clientMeta.LogDiagnostics(fmt.Sprintf("taskId = %s, wait for memory", taskId), memoryDiagnostics)
+		x.sendDiagnostics(task, memoryDiagnostics)
+	}
+
+	// The less memory left, the fewer results are allowed to pile up in the channel
+	resultChannel := make(chan any, x.memoryGovernor.ResultChannelBufferSize(adaptiveResultChannelBufferSize(task, x.statistics)))
+
+	// When the table has enough samples, the Pull is told to stop by cancelling this context
+	pullCtx, cancelPull := context.WithCancel(task.Context())
+	defer cancelPull()
+	isSampledOut := atomic.Bool{}
 
 	// step 1. Start a coroutine that pulls data
-	// TODO The size of the channel that receives the result is determined dynamically
 	wg.Add(1)
 	go func() {
 
@@ -191,8 +416,10 @@ func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 
 				msg := strings.Builder{}
 				msg.WriteString(fmt.Sprintf("taskId = %s, cost = %s, table %s data source pull table panic: %s", taskId, taskExecCost.String(), table.TableName, r))
+				x.watermarkTaskFailed(task)
+				x.addErrorCount(task, 1, isIgnorePullTableError)
 				if !isIgnorePullTableError {
-					task.DiagnosticsChannel <- NewDiagnostics().AddErrorMsg(msg.String())
+					x.sendDiagnostics(task, NewDiagnostics().AddErrorMsg(msg.String()))
 				}
 
 				if task.ParentRow != nil {
@@ -215,32 +442,36 @@ func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 
 		x.clientMeta.DebugF("taskId = %s, begin execution pull table...", taskId)
 
-		memory := GetMemoryUsage()
+		d := x.pullWithRetry(pullCtx, task, resultChannel)
 
-		for {
-			f, _ := os.Create("memory")
-			f.Write([]byte(fmt.Sprintf("%d", memory)))
-			if memory > 512 {
-				time.Sleep(time.Second * 1)
-				memory = GetMemoryUsage()
-			} else {
-				break
-			}
+		// The Pull is stopped on purpose, the errors caused by the cancellation are not errors of the table
+		if isSampledOut.Load() && d != nil && d.HasError() {
+			x.clientMeta.DebugF("taskId = %s, pull stopped by sampling, drop its diagnostics: %s", taskId, d.ToString())
+			d = nil
 		}
 
-		d := task.Table.DataSource.Pull(context.Background(), x.clientMeta, task.Client, task, resultChannel)
+		// Too many auth failures in a row, the remaining tasks of the client are skipped, it is reported even if the errors are ignored
+		if task.IsExpandDone && x.circuitBreaker.record(task.Client, d) {
+			x.clientMeta.ErrorF("taskId = %s, client %s failed auth %d times in a row, open its circuit", taskId, getClientKey(task.Client), x.circuitBreaker.threshold)
+			x.sendDiagnostics(task, NewDiagnostics().AddErrorMsg("client %s failed auth %d times in a row, its remaining tasks are skipped", getClientKey(task.Client), x.circuitBreaker.threshold))
+		}
 
 		taskExecCost := time.Now().Sub(taskExecBegin)
 		// If ignore errors are configured, the error message is typed into the log, although it is not thrown upward
 		x.clientMeta.DebugF("taskId = %s, execution pull table done, cost = %s", taskId, taskExecCost.String())
 		// Print it out at the appropriate level
 		x.clientMeta.LogDiagnostics(fmt.Sprintf("taskId = %s", taskId), d)
+		if d != nil && d.HasError() {
+			x.watermarkTaskFailed(task)
+			x.addErrorCount(task, countDiagnosticsErrors(d), isIgnorePullTableError)
+			SpanFromContext(task.Context()).SetDiagnostics(d)
+		}
 
 		// send diagnostics if not ignore error
 		if x.errorsHandlerMeta.IsIgnore(IgnoredErrorOnPullTable) {
 			return
 		} else if d != nil {
-			task.DiagnosticsChannel <- d
+			x.sendDiagnostics(task, d)
 		}
 
 	}()
@@ -254,8 +485,10 @@ func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 
 				msg := strings.Builder{}
 				msg.WriteString(fmt.Sprintf("taskId = %s, table %s data source pull table, handle result panic: %s", taskId, table.TableName, err))
+				x.watermarkTaskFailed(task)
+				x.addErrorCount(task, 1, isIgnorePullTableError)
 				if !isIgnorePullTableError {
-					task.DiagnosticsChannel <- NewDiagnostics().AddErrorMsg(msg.String())
+					x.sendDiagnostics(task, NewDiagnostics().AddErrorMsg(msg.String()))
 				}
 
 				if task.ParentRow != nil {
@@ -275,68 +508,129 @@ func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 
 		// Number of task results statistics
 		taskResultCount := 0
-		// collect result
-		for result := range resultChannel {
-
-			taskResultCount++
-
-			// drop nil result
-			if reflect_util.IsNil(result) {
-				x.clientMeta.DebugF("taskId = %s, return nil result, ignored it", taskId)
-				continue
-			}
-			x.clientMeta.DebugF("taskId = %s, receive one result, taskResultCount = %d", taskId, taskResultCount)
-
-			// run task result handler
-			execResultHandlerBeginTime := time.Now()
-			rows, resultSlice, d := x.execResultHandlerWithRecover(task.Ctx, x.clientMeta, task.Client, task, result)
-			execResultHandlerCost := time.Now().Sub(execResultHandlerBeginTime)
-			x.clientMeta.InfoF("taskId = %s, execResultHandlerCost = %s", taskId, execResultHandlerCost.String())
-			x.clientMeta.LogDiagnostics(fmt.Sprintf("taskId = %s", task.TaskId), d)
-			if d != nil && d.HasError() {
-				if !isIgnorePullTableError {
-					task.DiagnosticsChannel <- d
+		// <subTableName, started child task count>
+		childTaskCountMap := make(map[string]uint64)
+		// collect result, the results of a ResultBatch are handled one by one
+		for message := range resultChannel {
+
+			// All the results before the cursor are handled, the dropped results are not saved, so the cursor is not moved after that
+			if marker, ok := message.(*checkpointCursorMarker); ok {
+				if !task.IsCancelled() && !isSampledOut.Load() {
+					x.clientMeta.LogDiagnostics(fmt.Sprintf("taskId = %s, checkpoint cursor", task.logId()), task.SetCheckpointCursor(marker.cursor))
 				}
-			} else {
-				task.DiagnosticsChannel <- d
-			}
-			if rows == nil || rows.IsEmpty() {
-				x.clientMeta.DebugF("taskId = %s, task result handler return nil rows", taskId)
 				continue
 			}
 
-			// The current table parsed to the result of matrix transformation, and divided into a number of only one row of matrices
-			rowSlice := rows.SplitRowByRow()
-			if len(rowSlice) != len(resultSlice) {
-				x.clientMeta.ErrorF("taskId = %s, len(rowSlice) != len(resultSlice)", taskId)
-				continue
-			}
-			for i := 0; i < len(rowSlice); i++ {
-				row := rowSlice[i]
-				result := resultSlice[i]
-				// Start a data pull task for each child table
-				for _, subTable := range task.Table.SubTables {
-					subTask := &DataSourcePullTask{
-
-						TaskId: id_util.RandomId(),
-						Ctx:    task.Ctx,
-
-						ParentTask:      task,
-						ParentTable:     task.Table,
-						ParentRow:       row,
-						ParentRawResult: result,
-
-						Table:              subTable,
-						ResultHandler:      task.ResultHandler,
-						TaskDoneCallback:   task.TaskDoneCallback,
-						DiagnosticsChannel: task.DiagnosticsChannel,
-
-						IsRootTask:   false,
-						IsExpandDone: true,
-						Client:       task.Client,
+			for _, result := range unpackResults(message) {
+
+				taskResultCount++
+				x.statistics.update(task, func(counters *PullCounters) {
+					counters.RawResultCount++
+				})
+
+				// After cancelled, the result is still read out so that the Pull will not block on send, but it is dropped
+				if task.IsCancelled() || isSampledOut.Load() {
+					x.addDroppedResultCount(task)
+					continue
+				}
+
+				// The table has enough samples, stop the Pull, the table is not complete so the watermark is not moved
+				if !x.sampling.acceptResult(task) {
+					if isSampledOut.CompareAndSwap(false, true) {
+						x.clientMeta.DebugF("taskId = %s, table %s has %d sampled results, stop pull", taskId, table.TableName, x.sampling.MaxResultsPerTable)
+						task.isSampledOut = true
+						x.watermarkTaskFailed(task)
+						cancelPull()
+					}
+					x.addDroppedResultCount(task)
+					continue
+				}
+
+				// drop nil result
+				if reflect_util.IsNil(result) {
+					x.clientMeta.DebugF("taskId = %s, return nil result, ignored it", taskId)
+					x.addDroppedResultCount(task)
+					continue
+				}
+				x.clientMeta.DebugF("taskId = %s, receive one result, taskResultCount = %d", taskId, taskResultCount)
+
+				// run task result handler
+				execResultHandlerBeginTime := time.Now()
+				rows, resultSlice, d := x.execResultHandlerWithRecover(task.Context(), x.clientMeta, task.Client, task, result)
+				execResultHandlerCost := time.Now().Sub(execResultHandlerBeginTime)
+				x.clientMeta.InfoF("taskId = %s, execResultHandlerCost = %s", taskId, execResultHandlerCost.String())
+				x.clientMeta.LogDiagnostics(fmt.Sprintf("taskId = %s", task.logId()), d)
+				if d != nil && d.HasError() {
+					x.watermarkTaskFailed(task)
+					x.addErrorCount(task, countDiagnosticsErrors(d), isIgnorePullTableError)
+					if !isIgnorePullTableError {
+						x.sendDiagnostics(task, d)
+					}
+				} else {
+					x.sendDiagnostics(task, d)
+				}
+				if rows == nil || rows.IsEmpty() {
+					x.clientMeta.DebugF("taskId = %s, task result handler return nil rows", taskId)
+					x.addDroppedResultCount(task)
+					continue
+				}
+				x.statistics.update(task, func(counters *PullCounters) {
+					counters.RowCount += int64(rows.RowCount())
+				})
+
+				// The rows are saved, move the watermark forward
+				if x.watermark != nil {
+					x.watermark.observe(task, rows)
+				}
+
+				// The current table parsed to the result of matrix transformation, and divided into a number of only one row of matrices
+				rowSlice := rows.SplitRowByRow()
+				if len(rowSlice) != len(resultSlice) {
+					x.clientMeta.ErrorF("taskId = %s, len(rowSlice) != len(resultSlice)", taskId)
+					continue
+				}
+				for i := 0; i < len(rowSlice); i++ {
+					row := rowSlice[i]
+					result := resultSlice[i]
+					// Start a data pull task for each child table
+					for _, subTable := range task.Table.SubTables {
+						if !x.sampling.acceptChildTask(childTaskCountMap[subTable.TableName]) {
+							continue
+						}
+						childTaskCountMap[subTable.TableName]++
+						subTask := &DataSourcePullTask{
+
+							TaskId: id_util.RandomId(),
+							Ctx:    task.Context(),
+
+							ParentTask:      task,
+							ParentTable:     task.Table,
+							ParentRow:       row,
+							ParentRawResult: result,
+
+							Table:              subTable,
+							ResultHandler:      task.ResultHandler,
+							TaskDoneCallback:   task.TaskDoneCallback,
+							TableDoneCallback:  task.TableDoneCallback,
+							DiagnosticsChannel: task.DiagnosticsChannel,
+
+							IsRootTask:   false,
+							IsExpandDone: true,
+							Client:       task.Client,
+
+							checkpointExpansionKey: task.checkpointExpansionKey,
+						}
+						// the child of the result can not be identified has no cursor saved
+						if task.checkpointKey != "" {
+							if fingerprint, err := resultFingerprint(result); err == nil {
+								subTask.checkpointKey = task.checkpointKey + pullCheckpointKeySeparator + subTable.TableName + pullCheckpointKeySeparator + fmt.Sprintf("%x", fingerprint)
+							} else {
+								x.clientMeta.DebugF("taskId = %s, subTaskId = %s, parent raw result can not be identified, no checkpoint cursor: %s", task.logId(), subTask.TaskId, err.Error())
+							}
+						}
+						x.clientMeta.DebugF("taskId = %s, start subTaskId = %s, parent row = %s, parent raw result = %s", task.logId(), subTask.TaskId, row, result)
+						x.Submit(task.Context(), subTask)
 					}
-					x.clientMeta.DebugF("taskId = %s, start subTaskId = %s, parent row = %s, parent raw result = %s", task.TaskId, subTask.TaskId, row, result)
-					x.Submit(context.Background(), subTask)
 				}
 			}
 		}
@@ -348,15 +642,178 @@ func (x *DataSourceExecutor) execTask(task *DataSourcePullTask) {
 
 	// Waiting for the two of you to finish
 	wg.Wait()
+	x.statistics.taskDone(task, taskBegin, time.Now())
 
 	taskCost := time.Now().Sub(taskBegin)
 	x.clientMeta.DebugF("taskId = %s, execution done, cost = %s", taskId, taskCost.String())
 }
 
+// Callback that the table is done while capturing Panic
+func (x *DataSourceExecutor) execTableDoneCallback(task *DataSourcePullTask, table *Table) {
+
+	defer func() {
+		if r := recover(); r != nil {
+			x.clientMeta.ErrorF("taskId = %s, table %s done callback panic: %v, stack: %s", task.logId(), table.TableName, r, runtime_util.Stack())
+			task.DiagnosticsChannel <- NewDiagnostics().AddErrorMsg("table %s done callback panic: %v", table.TableName, r)
+		}
+	}()
+
+	x.clientMeta.DebugF("taskId = %s, table %s is done", task.logId(), table.TableName)
+	if task.TableDoneCallback == nil {
+		return
+	}
+	if d := task.TableDoneCallback(task.Context(), x.clientMeta, task, table); d != nil {
+		task.DiagnosticsChannel <- d
+	}
+}
+
+// Send the diagnostics of the task to the host, marked with the client of the task
+func (x *DataSourceExecutor) sendDiagnostics(task *DataSourcePullTask, diagnostics *Diagnostics) {
+	if task.IsExpandDone {
+		diagnostics.TagClient(task.Client)
+	}
+	task.DiagnosticsChannel <- diagnostics
+}
+
+// The errors reported to the host, or ignored because of the ErrorsHandlerMeta
+func (x *DataSourceExecutor) addErrorCount(task *DataSourcePullTask, count int64, isIgnored bool) {
+	x.statistics.update(task, func(counters *PullCounters) {
+		if isIgnored {
+			counters.IgnoredErrorCount += count
+		} else {
+			counters.ErrorCount += count
+		}
+	})
+}
+
+func (x *DataSourceExecutor) addDroppedResultCount(task *DataSourcePullTask) {
+	x.statistics.update(task, func(counters *PullCounters) {
+		counters.DroppedResultCount++
+	})
+}
+
+func (x *DataSourceExecutor) watermarkTaskFailed(task *DataSourcePullTask) {
+	if x.watermark != nil {
+		x.watermark.taskFailed(task)
+	}
+}
+
+// Pull the data source, if the table has a retry policy, retry it when the error is retryable
+func (x *DataSourceExecutor) pullWithRetry(ctx context.Context, task *DataSourcePullTask, resultChannel chan any) *Diagnostics {
+
+	retryPolicy := task.Table.GetRetryPolicy()
+	if retryPolicy == nil || retryPolicy.MaxAttempts <= 1 {
+		// One pull takes one token of the table's rate limit
+		if err := task.WaitRateLimit(ctx, task.Table.GetRateLimit()); err != nil {
+			x.clientMeta.DebugF("taskId = %s, wait for rate limit error: %s", task.logId(), err.Error())
+			return nil
+		}
+		return x.execPull(ctx, task, resultChannel, 1)
+	}
+
+	// The results emitted by the failed attempts have been handled, the retry should not emit them again
+	deduplicator := newPullResultDeduplicator(x.clientMeta, task)
+	pullBegin := time.Now()
+	for attempt := 1; ; attempt++ {
+
+		if err := task.WaitRateLimit(ctx, task.Table.GetRateLimit()); err != nil {
+			x.clientMeta.DebugF("taskId = %s, wait for rate limit error: %s", task.logId(), err.Error())
+			return nil
+		}
+
+		d := x.pullAttempt(ctx, task, resultChannel, deduplicator, attempt)
+		if !d.IsRetryable() || ctx.Err() != nil || !retryPolicy.IsRetryAllowed(attempt+1, time.Since(pullBegin)) {
+			return d
+		}
+
+		x.statistics.update(task, func(counters *PullCounters) {
+			counters.RetryCount++
+		})
+		backoff := retryPolicy.Backoff(attempt, d.IsThrottled())
+		x.clientMeta.WarnF("taskId = %s, table %s pull attempt %d failed, retry after %s, error: %s", task.logId(), task.Table.TableName, attempt, backoff.String(), d.ToString())
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return d
+		case <-timer.C:
+		}
+	}
+}
+
+// Pull once, the results go through the deduplicator before being sent to the result channel
+func (x *DataSourceExecutor) pullAttempt(ctx context.Context, task *DataSourcePullTask, resultChannel chan any, deduplicator *pullResultDeduplicator, attempt int) *Diagnostics {
+
+	deduplicator.beginAttempt()
+
+	// No buffer here, the results are buffered only by the result channel, which is sized for the task
+	attemptChannel := make(chan any)
+	task.attemptChannel = attemptChannel
+	forwardDone := make(chan struct{})
+	go func() {
+		defer close(forwardDone)
+		// after the context is done, the rest results are dropped, but still received so that the Pull is not blocked
+		forward := func(message any) {
+			if err := task.Emit(ctx, resultChannel, message); err != nil {
+				x.clientMeta.DebugF("taskId = %s, forward the result of attempt %d error: %s", task.logId(), attempt, err.Error())
+			}
+		}
+		for message := range attemptChannel {
+			// the cursor is not a result, it always goes after the results before it
+			if _, isMarker := message.(*checkpointCursorMarker); isMarker {
+				forward(message)
+				continue
+			}
+			batch, isBatch := message.(ResultBatch)
+			if !isBatch {
+				if deduplicator.isNew(message) {
+					forward(message)
+				}
+				continue
+			}
+			// the batch may be partly sent by the failed attempt
+			newResults := make(ResultBatch, 0, len(batch))
+			for _, result := range batch {
+				if deduplicator.isNew(result) {
+					newResults = append(newResults, result)
+				}
+			}
+			if len(newResults) != 0 {
+				forward(newResults)
+			}
+		}
+	}()
+	// also when Pull panic, so all the results are forwarded before the result channel is closed
+	defer func() {
+		close(attemptChannel)
+		<-forwardDone
+		task.attemptChannel = nil
+	}()
+
+	return x.execPull(ctx, task, attemptChannel, attempt)
+}
+
+// Run the DataSource.Pull once in its own span, the spans started by the Pull are the children of it
+func (x *DataSourceExecutor) execPull(ctx context.Context, task *DataSourcePullTask, resultChannel chan<- any, attempt int) (diagnostics *Diagnostics) {
+	ctx, span := StartSpan(ctx, "pull")
+	span.SetAttribute("attempt", attempt)
+	isPanic := true
+	defer func() {
+		if isPanic {
+			span.SetError("data source pull panic")
+		}
+		span.SetDiagnostics(diagnostics).End()
+	}()
+
+	diagnostics = task.Table.DataSource.Pull(ctx, x.clientMeta, task.Client, task, resultChannel)
+	isPanic = false
+	return diagnostics
+}
+
 // Expand the task, initialize the relevant task context, and so on
 func (x *DataSourceExecutor) expandTask(ctx context.Context, task *DataSourcePullTask) {
 
-	taskId := task.TaskId
+	taskId := task.logId()
 
 	x.clientMeta.DebugF("taskId = %s, begin expand...", taskId)
 
@@ -375,7 +832,7 @@ func (x *DataSourceExecutor) expandTask(ctx context.Context, task *DataSourcePul
 	for _, client := range clientSlice {
 		// expand task if necessary
 		if task.Table != nil && task.Table.ExpandClientTask != nil {
-			for _, clientTaskContext := range task.Table.ExpandClientTask(task.Ctx, x.clientMeta, client, task) {
+			for _, clientTaskContext := range task.Table.ExpandClientTask(ctx, x.clientMeta, client, task) {
 				// You can omit the task field, will use default task's clone
 				if clientTaskContext.Task == nil {
 					clientTaskContext.Task = task.Clone()
@@ -396,12 +853,19 @@ func (x *DataSourceExecutor) expandTask(ctx context.Context, task *DataSourcePul
 	}
 
 	// send new task
-	for _, clientTaskContext := range clientTaskContextSlice {
+	for index, clientTaskContext := range clientTaskContextSlice {
 		expandTask := clientTaskContext.Task
 		// generate new task id
 		expandTask.TaskId = expandTask.TaskId + "-" + id_util.RandomId()
 		expandTask.Client = clientTaskContext.Client
 		expandTask.IsExpandDone = true
+		// The expansion is identified by its index, the completed one is skipped when the pull is resumed
+		expandTask.checkpointExpansionKey = task.Table.TableName + pullCheckpointKeySeparator + strconv.Itoa(index)
+		expandTask.checkpointKey = expandTask.checkpointExpansionKey
+		if x.checkpoint != nil && x.checkpoint.IsExpansionCompleted(expandTask.checkpointExpansionKey) {
+			x.clientMeta.DebugF("taskId = %s, expansion %s is completed in checkpoint, skip it", taskId, expandTask.checkpointExpansionKey)
+			continue
+		}
 		x.Submit(ctx, expandTask)
 		x.clientMeta.DebugF("taskId = %s, expand new task id = %s", taskId, expandTask.TaskId)
 	}
@@ -413,6 +877,15 @@ func (x *DataSourceExecutor) execResultHandlerWithRecover(ctx context.Context, c
 
 	diagnostics = NewDiagnostics()
 
+	ctx, span := StartSpan(ctx, "result_handler")
+	// Registered before the recover, so that it runs after the panic is recovered
+	defer func() {
+		if rows != nil {
+			span.SetAttribute("row_count", rows.RowCount())
+		}
+		span.SetDiagnostics(diagnostics).End()
+	}()
+
 	defer func() {
 
 		if err := recover(); err != nil {
@@ -421,9 +894,11 @@ func (x *DataSourceExecutor) execResultHandlerWithRecover(ctx context.Context, c
 			rows = nil
 
 			msg := strings.Builder{}
-			msg.WriteString(fmt.Sprintf("taskId = %s, exec result handler panic: %s", task.TaskId, err))
+			msg.WriteString(fmt.Sprintf("taskId = %s, exec result handler panic: %s", task.logId(), err))
 			if !x.errorsHandlerMeta.IsIgnore(IgnoredErrorOnPullTable) {
 				diagnostics.AddErrorMsg(msg.String())
+			} else {
+				x.addErrorCount(task, 1, true)
 			}
 
 			msg.WriteString(fmt.Sprintf("\n result:  %s \n", result))
@@ -447,134 +922,8 @@ func (x *DataSourceExecutor) execResultHandlerWithRecover(ctx context.Context, c
 	resultHandlerBegin := time.Now()
 	rows, resultSlice, diagnostics = task.ResultHandler(ctx, x.clientMeta, client, task, result)
 	cost := time.Now().Sub(resultHandlerBegin)
-	clientMeta.DebugF("taskId = %s, exec ResultHandler, cost = %s", task.TaskId, cost.String())
+	clientMeta.DebugF("taskId = %s, exec ResultHandler, cost = %s", task.logId(), cost.String())
 	return
 }
 
 // ---------------------------------------------------------------------------------------------------------------------
-
-// DataSourcePullTaskQueue A dedicated task queue allows you to expand the task queue at will
-type DataSourcePullTaskQueue struct {
-	lock sync.RWMutex
-	list *singlylinkedlist.List
-}
-
-func NewDataSourcePullTaskQueue() *DataSourcePullTaskQueue {
-	return &DataSourcePullTaskQueue{
-		lock: sync.RWMutex{},
-		list: &singlylinkedlist.List{},
-	}
-}
-
-func (x *DataSourcePullTaskQueue) Add(task *DataSourcePullTask) {
-	x.lock.Lock()
-	defer x.lock.Unlock()
-
-	x.list.Add(task)
-}
-
-func (x *DataSourcePullTaskQueue) Take() *DataSourcePullTask {
-	x.lock.Lock()
-	defer x.lock.Unlock()
-
-	value, ok := x.list.Get(0)
-	if ok {
-		x.list.Remove(0)
-		return value.(*DataSourcePullTask)
-	} else {
-		return nil
-	}
-}
-
-func (x *DataSourcePullTaskQueue) IsEmpty() bool {
-	x.lock.RLock()
-	defer x.lock.RUnlock()
-
-	return x.list.Empty()
-}
-
-// ---------------------------------------------------------------------------------------------------------------------
-
-// ConsumerSemaphore Used to coordinate the work and exit of all consumers
-type ConsumerSemaphore struct {
-	lock                 sync.RWMutex
-	consumerIdleCountMap map[uint64]int
-
-	clientMeta *ClientMeta
-}
-
-func NewConsumerSemaphore(clientMeta *ClientMeta) *ConsumerSemaphore {
-	return &ConsumerSemaphore{
-		lock:                 sync.RWMutex{},
-		consumerIdleCountMap: make(map[uint64]int),
-		clientMeta:           clientMeta,
-	}
-}
-
-func (x *ConsumerSemaphore) Init(consumerId uint64) {
-	x.lock.Lock()
-	defer x.lock.Unlock()
-
-	x.consumerIdleCountMap[consumerId] = 0
-}
-
-func (x *ConsumerSemaphore) Running(consumerId uint64) {
-	x.lock.Lock()
-	defer x.lock.Unlock()
-
-	x.consumerIdleCountMap[consumerId] = 0
-
-	//// for log
-	//if x.clientMeta != nil {
-	//	idleCount := 0
-	//	for _, count := range x.consumerIdleCountMap {
-	//		if count != 0 {
-	//			idleCount++
-	//		}
-	//	}
-	//	x.clientMeta.Debug("ConsumerSemaphore Running", zap.Int("total", len(x.consumerIdleCountMap)), zap.Int("idle", idleCount))
-	//}
-}
-
-func (x *ConsumerSemaphore) Idle(consumerId uint64) {
-	x.lock.Lock()
-	defer x.lock.Unlock()
-
-	idleCount, exists := x.consumerIdleCountMap[consumerId]
-	if exists {
-		idleCount += 1
-	} else {
-		idleCount = 1
-	}
-	x.consumerIdleCountMap[consumerId] = idleCount
-
-	//// for log
-	//if x.clientMeta != nil {
-	//	idleCount := 0
-	//	for _, count := range x.consumerIdleCountMap {
-	//		if count != 0 {
-	//			idleCount++
-	//		}
-	//	}
-	//	x.clientMeta.Debug("ConsumerSemaphore Idle", zap.Int("total", len(x.consumerIdleCountMap)), zap.Int("idle", idleCount))
-	//}
-}
-
-func (x *ConsumerSemaphore) IsAllConsumerDone() bool {
-	x.lock.RLock()
-	defer x.lock.RUnlock()
-
-	for _, idleCount := range x.consumerIdleCountMap {
-		if idleCount < 3 {
-			return false
-		}
-	}
-
-	if x.clientMeta != nil {
-		x.clientMeta.Debug("ConsumerSemaphore IsAllConsumerDone return true")
-	}
-
-	return true
-}
-
-// ---------------------------------------------------------------------------------------------------------------------
@@ -0,0 +1,280 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestExecutor(t *testing.T, workerNum uint64) *DataSourceExecutor {
+	clientMeta := &ClientMeta{}
+	_, d := NewClientMetaRuntime(context.Background(), t.TempDir(), "test", "v0.0.1", clientMeta, nil, false)
+	assert.False(t, d.HasError())
+	errorsHandlerMeta := &ErrorsHandlerMeta{}
+	errorsHandlerMeta.runtime = NewErrorsHandlerMetaRuntime(errorsHandlerMeta)
+	executor, d := NewDataSourcePullExecutor(workerNum, clientMeta, errorsHandlerMeta)
+	assert.False(t, d.HasError())
+	return executor
+}
+
+func TestDataSourceExecutor_Cancel(t *testing.T) {
+
+	executor := newTestExecutor(t, 2)
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Millisecond*200)
+	defer cancelFunc()
+
+	var pullStartCount, pullExitCount, doneCount int32
+	table := &Table{
+		TableName: "test_cancel_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				// a data source that only stop when the context is done
+				atomic.AddInt32(&pullStartCount, 1)
+				<-ctx.Done()
+				atomic.AddInt32(&pullExitCount, 1)
+				return nil
+			},
+		},
+	}
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	for i := 0; i < 4; i++ {
+		executor.Submit(ctx, &DataSourcePullTask{
+			TaskId:             id_util.RandomId(),
+			Ctx:                ctx,
+			Table:              table,
+			DiagnosticsChannel: diagnosticsChannel,
+			TaskDoneCallback: func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask) *Diagnostics {
+				atomic.AddInt32(&doneCount, 1)
+				return nil
+			},
+			TableDoneCallback: func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask, table *Table) *Diagnostics {
+				atomic.AddInt32(&doneCount, 1)
+				return nil
+			},
+			IsRootTask:   true,
+			IsExpandDone: true,
+		})
+	}
+	executor.ShutdownAndAwaitTermination(ctx)
+
+	// the running pulls stop with the context, the rest tasks are dropped, no task or table is done
+	assert.LessOrEqual(t, atomic.LoadInt32(&pullStartCount), int32(2))
+	assert.Equal(t, atomic.LoadInt32(&pullStartCount), atomic.LoadInt32(&pullExitCount))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&doneCount))
+}
+
+func TestDataSourceExecutor_TaskTree(t *testing.T) {
+
+	executor := newTestExecutor(t, 4)
+
+	var doneCount int32
+	subTable := &Table{
+		TableName: "test_tree_sub_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				resultChannel <- "sub"
+				return nil
+			},
+		},
+	}
+	rootTable := &Table{
+		TableName: "test_tree_root_table",
+		SubTables: []*Table{subTable},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				// child tasks are submitted a little later, the executor should still wait for them
+				time.Sleep(time.Millisecond * 100)
+				for i := 0; i < 3; i++ {
+					resultChannel <- i
+				}
+				return nil
+			},
+		},
+	}
+	resultHandler := func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+		rows := NewRows("value")
+		_ = rows.AppendRowValues([]any{result})
+		return rows, []any{result}, nil
+	}
+
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	begin := time.Now()
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId:             id_util.RandomId(),
+		Ctx:                context.Background(),
+		Table:              rootTable,
+		ResultHandler:      resultHandler,
+		DiagnosticsChannel: diagnosticsChannel,
+		TaskDoneCallback: func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask) *Diagnostics {
+			atomic.AddInt32(&doneCount, 1)
+			return nil
+		},
+		IsRootTask:   true,
+		IsExpandDone: true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+
+	// one root task and three child tasks, and no idle polling
+	assert.Equal(t, int32(4), atomic.LoadInt32(&doneCount))
+	assert.Less(t, time.Since(begin), time.Second*2)
+}
+
+func TestDataSourceExecutor_TableDone(t *testing.T) {
+
+	executor := newTestExecutor(t, 4)
+	executor.clientMeta.runtime.client = []any{"client-a", "client-b"}
+
+	var rootPullDoneCount, subPullDoneCount int32
+	// the sub table of a table without any row is done as soon as its parent table is done
+	grandSubTable := &Table{
+		TableName: "test_table_done_grand_sub_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				return nil
+			},
+		},
+	}
+	emptySubTable := &Table{
+		TableName: "test_table_done_empty_sub_table",
+		SubTables: []*Table{grandSubTable},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				return nil
+			},
+		},
+	}
+	subTable := &Table{
+		TableName: "test_table_done_sub_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				time.Sleep(time.Millisecond * 30)
+				resultChannel <- "sub"
+				atomic.AddInt32(&subPullDoneCount, 1)
+				return nil
+			},
+		},
+	}
+	rootTable := &Table{
+		TableName: "test_table_done_root_table",
+		SubTables: []*Table{subTable, emptySubTable},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				// the second client is slower, the root table is not done until it finishes
+				if client == "client-b" {
+					time.Sleep(time.Millisecond * 100)
+				}
+				resultChannel <- 1
+				resultChannel <- 2
+				atomic.AddInt32(&rootPullDoneCount, 1)
+				return nil
+			},
+		},
+	}
+	resultHandler := func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+		if task.Table == emptySubTable {
+			return nil, nil, nil
+		}
+		rows := NewRows("value")
+		_ = rows.AppendRowValues([]any{result})
+		return rows, []any{result}, nil
+	}
+
+	doneTables := make([]string, 0)
+	doneTablesLock := sync.Mutex{}
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId:             id_util.RandomId(),
+		Ctx:                context.Background(),
+		Table:              rootTable,
+		ResultHandler:      resultHandler,
+		DiagnosticsChannel: make(chan *Diagnostics, 100),
+		TableDoneCallback: func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask, table *Table) *Diagnostics {
+			switch table {
+			case rootTable:
+				assert.Equal(t, int32(2), atomic.LoadInt32(&rootPullDoneCount))
+			case subTable:
+				assert.Equal(t, int32(4), atomic.LoadInt32(&subPullDoneCount))
+			}
+			doneTablesLock.Lock()
+			defer doneTablesLock.Unlock()
+			doneTables = append(doneTables, table.TableName)
+			return nil
+		},
+		IsRootTask: true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+
+	// each table is done exactly once, the parent table first
+	assert.Len(t, doneTables, 4)
+	assert.Equal(t, rootTable.TableName, doneTables[0])
+	assert.ElementsMatch(t, []string{rootTable.TableName, subTable.TableName, emptySubTable.TableName, grandSubTable.TableName}, doneTables)
+	indexOf := func(tableName string) int {
+		for index, doneTable := range doneTables {
+			if doneTable == tableName {
+				return index
+			}
+		}
+		return -1
+	}
+	assert.Less(t, indexOf(emptySubTable.TableName), indexOf(grandSubTable.TableName))
+}
+
+func TestDataSourceExecutor_Retry(t *testing.T) {
+
+	executor := newTestExecutor(t, 2)
+
+	var attemptCount int32
+	table := &Table{
+		TableName: "test_retry_table",
+		Options: &TableOptions{
+			RetryPolicy: &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond * 10},
+		},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				// the first attempt fail after emit two results, the retry emit all from the beginning
+				if atomic.AddInt32(&attemptCount, 1) == 1 {
+					resultChannel <- "a"
+					resultChannel <- "b"
+					return NewDiagnostics().AddRetryableErrorMsg("connection reset")
+				}
+				for _, result := range []string{"a", "b", "b", "c"} {
+					resultChannel <- result
+				}
+				return nil
+			},
+		},
+	}
+
+	results := make([]any, 0)
+	resultsLock := sync.Mutex{}
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  table,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			resultsLock.Lock()
+			defer resultsLock.Unlock()
+			results = append(results, result)
+			return nil, nil, nil
+		},
+		DiagnosticsChannel: diagnosticsChannel,
+		IsRootTask:         true,
+		IsExpandDone:       true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+	close(diagnosticsChannel)
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attemptCount))
+	assert.Equal(t, []any{"a", "b", "b", "c"}, results)
+	for d := range diagnosticsChannel {
+		if d != nil {
+			assert.False(t, d.HasError(), d.ToString())
+		}
+	}
+}
@@ -2,7 +2,9 @@ package schema
 
 import (
 	"context"
+	"fmt"
 	"sync"
+	"time"
 )
 
 // DataSourcePullTask Represents a data source pull task
@@ -30,9 +32,14 @@ type DataSourcePullTask struct {
 	// What happens to the pulled data
 	ResultHandler func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics)
 
-	// Callback method when the task is completed
+	// Callback method when the task is completed, it is called for each task, include the expanded client tasks and the child tasks,
+	// so it does not mean the table is done, use TableDoneCallback for that
 	TaskDoneCallback func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask) *Diagnostics
 
+	// Callback method when a table is done, that is all the tasks of the table are done, include all clients and all child tasks,
+	// it is called once for the root table and once for each sub table, the task is the last done task of the table or its parent table
+	TableDoneCallback func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask, table *Table) *Diagnostics
+
 	// You can pass some messages back at execution time
 	DiagnosticsChannel chan *Diagnostics
 
@@ -45,6 +52,29 @@ type DataSourcePullTask struct {
 
 	itemMap     map[string]any
 	itemMapLock sync.RWMutex
+
+	// Shared by all the tasks of a pull, set by the executor when the task is submitted
+	rateLimiter *RateLimiter
+	statistics  *PullStatistics
+	checkpoint  *PullCheckpoint
+	watermark   *PullWatermark
+	// Remember the primary keys of the saved rows
+	rowDeduplicator *rowDeduplicator
+	// The channel of the current retry attempt, the results in it are forwarded to the result channel, where the blocked time is counted
+	attemptChannel chan any
+	// The checked parameters of the table of this task, set by the executor when the task is submitted
+	parameters TableParameterValues
+
+	// Identify the task across pulls, used to save its cursor
+	checkpointKey string
+	// Which client expansion the task belongs to
+	checkpointExpansionKey string
+
+	// The task is not run because the circuit of its client is open, or the tables it depends on are not done
+	isSkipped bool
+
+	// The pull of the task is stopped early because its table has enough samples
+	isSampledOut bool
 }
 
 func (x *DataSourcePullTask) ensureItemMapInit() {
@@ -173,6 +203,127 @@ func (x *DataSourcePullTask) ClearItem() {
 	x.itemMap = make(map[string]any)
 }
 
+// Context The context of the task, never nil, the task is cancelled when it is done
+func (x *DataSourcePullTask) Context() context.Context {
+	if x.Ctx == nil {
+		return context.Background()
+	}
+	return x.Ctx
+}
+
+// WaitRateLimit Block until the rate limit allow one more request of this task's client, or the context is done,
+// call it before each request to the API if the Pull sends more than one request
+func (x *DataSourcePullTask) WaitRateLimit(ctx context.Context, limit *RateLimit) error {
+	if x.rateLimiter == nil || limit == nil {
+		return nil
+	}
+	return x.rateLimiter.Wait(ctx, x.Client, x.Table, limit)
+}
+
+// GetCheckpointCursor The cursor saved by the last interrupted pull for this task, for example the next page token,
+// empty if the pull is not resumed or there is no cursor saved
+func (x *DataSourcePullTask) GetCheckpointCursor() string {
+	if x.checkpoint == nil || x.checkpointKey == "" {
+		return ""
+	}
+	return x.checkpoint.GetCursor(x.checkpointKey)
+}
+
+// SetCheckpointCursor Save the cursor of this task, call it after the results before the cursor have been handled, so that when the pull
+// is resumed, the Pull can continue from the cursor. The results sent to the result channel may be still buffered, use EmitCheckpointCursor in the Pull
+func (x *DataSourcePullTask) SetCheckpointCursor(cursor string) *Diagnostics {
+	if x.checkpoint == nil || x.checkpointKey == "" {
+		return nil
+	}
+	return x.checkpoint.SetCursor(x.checkpointKey, cursor)
+}
+
+// GetPreviousWatermark The max value of TableOptions.IncrementalCursorColumn saved by the last successful pull of this table and client,
+// the Pull can only request the records newer than it, empty means pull in full, for example the first pull or a full resync
+func (x *DataSourcePullTask) GetPreviousWatermark() string {
+	if x.watermark == nil || x.Table.GetIncrementalCursorColumn() == "" {
+		return ""
+	}
+	return x.watermark.GetPrevious(x.Context(), x.watermarkKey())
+}
+
+// GetPreviousWatermarkTime Same as GetPreviousWatermark, but parse it as time, false if there is no watermark or it is not a time
+func (x *DataSourcePullTask) GetPreviousWatermarkTime() (time.Time, bool) {
+	watermark := x.GetPreviousWatermark()
+	if watermark == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, watermark)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// The watermark is kept per table and per client expansion
+func (x *DataSourcePullTask) watermarkKey() string {
+	if x.checkpointExpansionKey == "" {
+		return x.Table.TableName
+	}
+	return x.Table.TableName + pullCheckpointKeySeparator + x.checkpointExpansionKey
+}
+
+// GetParameters The parameters passed by the host for the table of this task, empty if the table is pulled without parameters
+func (x *DataSourcePullTask) GetParameters() TableParameterValues {
+	return x.parameters
+}
+
+// Whether the task or any of its parents is pulled with parameters, then only a part of the table is pulled
+func (x *DataSourcePullTask) isParameterized() bool {
+	for task := x; task != nil; task = task.ParentTask {
+		if len(task.parameters) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// TraceId The trace id of the pull, empty if the pull is not traced, log it to correlate the logs with the trace
+func (x *DataSourcePullTask) TraceId() string {
+	if span := SpanFromContext(x.Context()); span != nil {
+		return span.TraceId
+	}
+	return ""
+}
+
+// The task id in the log, with the trace id and span id if the task is traced
+func (x *DataSourcePullTask) logId() string {
+	span := SpanFromContext(x.Context())
+	if span == nil {
+		return x.TaskId
+	}
+	return fmt.Sprintf("%s, traceId = %s, spanId = %s", x.TaskId, span.TraceId, span.SpanId)
+}
+
+// GetClientKey The identity of the client of this task, see RateLimitClient
+func (x *DataSourcePullTask) GetClientKey() string {
+	return getClientKey(x.Client)
+}
+
+// GetClientLabels The labels of the client of this task, see LabeledClient
+func (x *DataSourcePullTask) GetClientLabels() map[string]string {
+	return GetClientLabels(x.Client)
+}
+
+// RootTable The table of the root task of this task
+func (x *DataSourcePullTask) RootTable() *Table {
+	task := x
+	for task.ParentTask != nil {
+		task = task.ParentTask
+	}
+	return task.Table
+}
+
+// IsCancelled Whether the task is cancelled or timeout, a cancelled task should not do anything more
+func (x *DataSourcePullTask) IsCancelled() bool {
+	return x.Context().Err() != nil
+}
+
 func (x *DataSourcePullTask) Clone() *DataSourcePullTask {
 
 	x.itemMapLock.Lock()
@@ -195,10 +346,21 @@ func (x *DataSourcePullTask) Clone() *DataSourcePullTask {
 		NotExpandRawResult: x.NotExpandRawResult,
 		ResultHandler:      x.ResultHandler,
 		TaskDoneCallback:   x.TaskDoneCallback,
+		TableDoneCallback:  x.TableDoneCallback,
 		DiagnosticsChannel: x.DiagnosticsChannel,
 
 		itemMap:     itemMap,
 		itemMapLock: sync.RWMutex{},
+		rateLimiter: x.rateLimiter,
+		statistics:  x.statistics,
+		checkpoint:  x.checkpoint,
+		watermark:   x.watermark,
+		parameters:  x.parameters,
+
+		rowDeduplicator: x.rowDeduplicator,
+
+		checkpointKey:          x.checkpointKey,
+		checkpointExpansionKey: x.checkpointExpansionKey,
 
 		IsRootTask:   x.IsRootTask,
 		IsExpandDone: x.IsExpandDone,
@@ -0,0 +1,266 @@
+package schema
+
+import (
+	"container/list"
+	"sync"
+)
+
+// DataSourcePullTaskQueue A dedicated task queue allows you to expand the task queue at will,
+// it also tracks how many tasks are not done, so the consumers know when the whole task tree is drained.
+//
+// The tasks are grouped by their root table, the root tables are scheduled by priority, and round-robin among the same priority,
+// so that a table with a lot of child tasks can not starve the others. A task is not taken if its table already has
+// TableOptions.MaxConcurrency tasks running. In a lane the tasks are queued by their table, so finding a task can run only
+// looks at the first task of each table, not all the tasks blocked by the concurrency limit.
+type DataSourcePullTaskQueue struct {
+	lock sync.Mutex
+	cond *sync.Cond
+
+	// <rootTableName, lane>
+	laneMap map[string]*dataSourcePullTaskLane
+	// The order in which the lanes are created, used for round-robin
+	laneSlice []*dataSourcePullTaskLane
+	// The round-robin begins from this lane next time
+	laneCursor int
+
+	// <tableName, running task count>
+	runningCountMap map[string]int
+
+	// <tableName, queued task count>
+	queuedCountMap map[string]int
+
+	// Increase for each added task, the smaller one is added earlier
+	sequence uint64
+
+	// The tasks in the queue, not taken yet
+	queuedTaskCount int
+
+	// The tasks added but not done yet, include the tasks in the queue and the running tasks
+	unfinishedTaskCount int
+
+	// No more root task will be added, the child tasks can still be added by the running tasks
+	isShutdown bool
+}
+
+// All the tasks of a root table, include the tasks of its sub tables
+type dataSourcePullTaskLane struct {
+	rootTableName string
+	priority      int
+
+	// <tableName, tasks of the table in the order of added>
+	tableTasksMap map[string]*list.List
+	// The order in which the tables are seen, so the scan is stable
+	tableNameSlice []string
+	taskCount      int
+}
+
+// A task waiting in the queue
+type queuedDataSourcePullTask struct {
+	task     *DataSourcePullTask
+	sequence uint64
+}
+
+func NewDataSourcePullTaskQueue() *DataSourcePullTaskQueue {
+	queue := &DataSourcePullTaskQueue{
+		laneMap:         make(map[string]*dataSourcePullTaskLane),
+		laneSlice:       make([]*dataSourcePullTaskLane, 0),
+		runningCountMap: make(map[string]int),
+		queuedCountMap:  make(map[string]int),
+	}
+	queue.cond = sync.NewCond(&queue.lock)
+	return queue
+}
+
+func (x *DataSourcePullTaskQueue) Add(task *DataSourcePullTask) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	rootTable := task.RootTable()
+	rootTableName := ""
+	if rootTable != nil {
+		rootTableName = rootTable.TableName
+	}
+	lane, exists := x.laneMap[rootTableName]
+	if !exists {
+		lane = &dataSourcePullTaskLane{
+			rootTableName:  rootTableName,
+			priority:       rootTable.GetPriority(),
+			tableTasksMap:  make(map[string]*list.List),
+			tableNameSlice: make([]string, 0),
+		}
+		x.laneMap[rootTableName] = lane
+		x.laneSlice = append(x.laneSlice, lane)
+	}
+	tableName := x.tableName(task)
+	tasks, exists := lane.tableTasksMap[tableName]
+	if !exists {
+		tasks = list.New()
+		lane.tableTasksMap[tableName] = tasks
+		lane.tableNameSlice = append(lane.tableNameSlice, tableName)
+	}
+	x.sequence++
+	tasks.PushBack(&queuedDataSourcePullTask{task: task, sequence: x.sequence})
+	lane.taskCount++
+	x.queuedCountMap[tableName]++
+	x.queuedTaskCount++
+	x.unfinishedTaskCount++
+	x.cond.Signal()
+}
+
+// Take Take a task without waiting, return nil if no task can run now
+func (x *DataSourcePullTaskQueue) Take() *DataSourcePullTask {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.take()
+}
+
+// WaitTake Take a task, wait until there is one can run, return nil only when the queue is shutdown and all tasks are done
+func (x *DataSourcePullTaskQueue) WaitTake() *DataSourcePullTask {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for {
+		if task := x.take(); task != nil {
+			return task
+		}
+		if x.isShutdown && x.unfinishedTaskCount == 0 {
+			return nil
+		}
+		x.cond.Wait()
+	}
+}
+
+// Pick the lane with the highest priority which has a task can run, begin from the cursor so that the same priority is round-robin
+func (x *DataSourcePullTaskQueue) take() *DataSourcePullTask {
+	if x.queuedTaskCount == 0 {
+		return nil
+	}
+
+	var bestLane *dataSourcePullTaskLane
+	var bestTasks *list.List
+	bestIndex := 0
+	laneCount := len(x.laneSlice)
+	for i := 0; i < laneCount; i++ {
+		index := (x.laneCursor + i) % laneCount
+		lane := x.laneSlice[index]
+		if lane.taskCount == 0 || (bestLane != nil && lane.priority <= bestLane.priority) {
+			continue
+		}
+		if tasks := x.firstRunnableTasks(lane); tasks != nil {
+			bestLane, bestTasks, bestIndex = lane, tasks, index
+		}
+	}
+	if bestLane == nil {
+		return nil
+	}
+
+	task := bestTasks.Remove(bestTasks.Front()).(*queuedDataSourcePullTask).task
+	tableName := x.tableName(task)
+	bestLane.taskCount--
+	x.queuedCountMap[tableName]--
+	x.queuedTaskCount--
+	x.runningCountMap[tableName]++
+	x.laneCursor = (bestIndex + 1) % laneCount
+	return task
+}
+
+// The tasks of the table in the lane which has the earliest added task can run, nil if all the tables are at the concurrency limit
+func (x *DataSourcePullTaskQueue) firstRunnableTasks(lane *dataSourcePullTaskLane) *list.List {
+	var firstTasks *list.List
+	var firstSequence uint64
+	for _, tableName := range lane.tableNameSlice {
+		tasks := lane.tableTasksMap[tableName]
+		if tasks.Len() == 0 {
+			continue
+		}
+		front := tasks.Front().Value.(*queuedDataSourcePullTask)
+		if firstTasks != nil && front.sequence > firstSequence {
+			continue
+		}
+		maxConcurrency := front.task.Table.GetMaxConcurrency()
+		if maxConcurrency <= 0 || x.runningCountMap[tableName] < maxConcurrency {
+			firstTasks, firstSequence = tasks, front.sequence
+		}
+	}
+	return firstTasks
+}
+
+func (x *DataSourcePullTaskQueue) tableName(task *DataSourcePullTask) string {
+	if task.Table == nil {
+		return ""
+	}
+	return task.Table.TableName
+}
+
+// Done Mark a task taken from the queue is done
+func (x *DataSourcePullTaskQueue) Done(task *DataSourcePullTask) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	tableName := x.tableName(task)
+	isSlotFreed := false
+	if x.runningCountMap[tableName] > 0 {
+		x.runningCountMap[tableName]--
+		isSlotFreed = true
+	}
+	x.unfinishedTaskCount--
+	if x.unfinishedTaskCount < 0 {
+		x.unfinishedTaskCount = 0
+	}
+
+	switch {
+	case x.unfinishedTaskCount == 0:
+		// All tasks are done, the waiting consumers may all exit
+		x.cond.Broadcast()
+	case isSlotFreed && x.queuedCountMap[tableName] > 0:
+		// A task blocked by the concurrency limit can run now, the freed slot is only for one of them
+		x.cond.Signal()
+	}
+}
+
+// Shutdown After shutdown, the consumers exit as soon as all tasks are done
+func (x *DataSourcePullTaskQueue) Shutdown() {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.isShutdown = true
+	x.cond.Broadcast()
+}
+
+func (x *DataSourcePullTaskQueue) IsEmpty() bool {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.queuedTaskCount == 0
+}
+
+// UnfinishedTaskCount The number of tasks added but not done
+func (x *DataSourcePullTaskQueue) UnfinishedTaskCount() int {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.unfinishedTaskCount
+}
+
+// QueuedTaskCount The number of tasks in the queue, not taken yet
+func (x *DataSourcePullTaskQueue) QueuedTaskCount() int {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.queuedTaskCount
+}
+
+// RunningTaskCountMap A copy of the running task count of each table, <tableName, running task count>
+func (x *DataSourcePullTaskQueue) RunningTaskCountMap() map[string]int {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	runningTaskCountMap := make(map[string]int, len(x.runningCountMap))
+	for tableName, count := range x.runningCountMap {
+		if count > 0 {
+			runningTaskCountMap[tableName] = count
+		}
+	}
+	return runningTaskCountMap
+}
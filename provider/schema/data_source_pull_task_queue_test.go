@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePullTaskQueue_Priority(t *testing.T) {
+	lowTable := &Table{TableName: "low"}
+	highTable := &Table{TableName: "high", Options: &TableOptions{Priority: 10}}
+
+	queue := NewDataSourcePullTaskQueue()
+	queue.Add(&DataSourcePullTask{TaskId: "low-1", Table: lowTable})
+	queue.Add(&DataSourcePullTask{TaskId: "high-1", Table: highTable})
+	queue.Add(&DataSourcePullTask{TaskId: "high-2", Table: highTable})
+
+	assert.Equal(t, "high-1", queue.Take().TaskId)
+	assert.Equal(t, "high-2", queue.Take().TaskId)
+	assert.Equal(t, "low-1", queue.Take().TaskId)
+	assert.Nil(t, queue.Take())
+	assert.True(t, queue.IsEmpty())
+	assert.Equal(t, 3, queue.UnfinishedTaskCount())
+}
+
+func TestDataSourcePullTaskQueue_RoundRobin(t *testing.T) {
+	bigTable := &Table{TableName: "big"}
+	bigSubTable := &Table{TableName: "big_sub"}
+	smallTable := &Table{TableName: "small"}
+
+	queue := NewDataSourcePullTaskQueue()
+	bigRootTask := &DataSourcePullTask{TaskId: "big-root", Table: bigTable}
+	for _, taskId := range []string{"big-sub-1", "big-sub-2", "big-sub-3"} {
+		// the child tasks of a root table are in the same lane with it
+		queue.Add(&DataSourcePullTask{TaskId: taskId, Table: bigSubTable, ParentTask: bigRootTask})
+	}
+	queue.Add(&DataSourcePullTask{TaskId: "small-1", Table: smallTable})
+	queue.Add(&DataSourcePullTask{TaskId: "small-2", Table: smallTable})
+
+	taskIds := make([]string, 0)
+	for task := queue.Take(); task != nil; task = queue.Take() {
+		taskIds = append(taskIds, task.TaskId)
+	}
+	assert.Equal(t, []string{"big-sub-1", "small-1", "big-sub-2", "small-2", "big-sub-3"}, taskIds)
+}
+
+func TestDataSourcePullTaskQueue_MaxConcurrency(t *testing.T) {
+	limitTable := &Table{TableName: "limit", Options: &TableOptions{MaxConcurrency: 1}}
+	otherTable := &Table{TableName: "other"}
+
+	queue := NewDataSourcePullTaskQueue()
+	queue.Add(&DataSourcePullTask{TaskId: "limit-1", Table: limitTable})
+	queue.Add(&DataSourcePullTask{TaskId: "limit-2", Table: limitTable})
+	queue.Add(&DataSourcePullTask{TaskId: "other-1", Table: otherTable})
+
+	limit1 := queue.Take()
+	assert.Equal(t, "limit-1", limit1.TaskId)
+	// limit-2 is blocked until limit-1 is done
+	assert.Equal(t, "other-1", queue.Take().TaskId)
+	assert.Nil(t, queue.Take())
+	queue.Done(limit1)
+	assert.Equal(t, "limit-2", queue.Take().TaskId)
+}
+
+func TestDataSourcePullTaskQueue_WaitTake(t *testing.T) {
+	queue := NewDataSourcePullTaskQueue()
+	queue.Add(&DataSourcePullTask{TaskId: "task-1", Table: &Table{TableName: "foo"}})
+	queue.Shutdown()
+
+	task := queue.WaitTake()
+	assert.Equal(t, "task-1", task.TaskId)
+
+	exit := make(chan struct{})
+	go func() {
+		assert.Nil(t, queue.WaitTake())
+		close(exit)
+	}()
+	// the consumer exit only after the last task is done
+	queue.Done(task)
+	<-exit
+	assert.Equal(t, 0, queue.UnfinishedTaskCount())
+}
+
+func TestDataSourcePullTaskQueue_WaitTakeMaxConcurrency(t *testing.T) {
+	limitTable := &Table{TableName: "limit", Options: &TableOptions{MaxConcurrency: 2}}
+
+	queue := NewDataSourcePullTaskQueue()
+	for i := 0; i < 20; i++ {
+		queue.Add(&DataSourcePullTask{TaskId: fmt.Sprintf("limit-%d", i), Table: limitTable})
+	}
+	queue.Shutdown()
+
+	// more consumers than the limit, the blocked ones are woken up when a slot is freed
+	var runningCount, maxRunningCount, takenCount int32
+	wg := sync.WaitGroup{}
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := queue.WaitTake(); task != nil; task = queue.WaitTake() {
+				running := atomic.AddInt32(&runningCount, 1)
+				for {
+					maxRunning := atomic.LoadInt32(&maxRunningCount)
+					if running <= maxRunning || atomic.CompareAndSwapInt32(&maxRunningCount, maxRunning, running) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				atomic.AddInt32(&takenCount, 1)
+				atomic.AddInt32(&runningCount, -1)
+				queue.Done(task)
+			}
+		}()
+	}
+	exit := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(exit)
+	}()
+	select {
+	case <-exit:
+	case <-time.After(time.Second * 10):
+		t.Fatal("the consumers are not woken up")
+	}
+	assert.Equal(t, int32(20), takenCount)
+	assert.LessOrEqual(t, maxRunningCount, int32(2))
+	assert.Equal(t, 0, queue.UnfinishedTaskCount())
+}
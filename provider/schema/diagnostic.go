@@ -43,6 +43,13 @@ func (x DiagnosticLevel) String() string {
 type Diagnostic struct {
 	level   DiagnosticLevel
 	content string
+
+	// Only make sense for the error level, tell whether the failed operation can be retried
+	errorClass ErrorClass
+
+	// Which client the diagnostic is about, empty if it is not about a client
+	clientKey    string
+	clientLabels map[string]string
 }
 
 func NewDiagnostic(level DiagnosticLevel, content string) *Diagnostic {
@@ -76,6 +83,33 @@ func (x *Diagnostic) Content() string {
 	return x.content
 }
 
+func (x *Diagnostic) ErrorClass() ErrorClass {
+	return x.errorClass
+}
+
+// WithErrorClass Mark the class of this error diagnostic
+func (x *Diagnostic) WithErrorClass(errorClass ErrorClass) *Diagnostic {
+	x.errorClass = errorClass
+	return x
+}
+
+// ClientKey The identity of the client the diagnostic is about, see RateLimitClient
+func (x *Diagnostic) ClientKey() string {
+	return x.clientKey
+}
+
+// ClientLabels The labels of the client the diagnostic is about, see LabeledClient
+func (x *Diagnostic) ClientLabels() map[string]string {
+	return x.clientLabels
+}
+
+// WithClient Mark which client the diagnostic is about
+func (x *Diagnostic) WithClient(clientKey string, clientLabels map[string]string) *Diagnostic {
+	x.clientKey = clientKey
+	x.clientLabels = clientLabels
+	return x
+}
+
 // ------------------------------------------------- -------------------------------------------------------------------
 
 // Diagnostics Represents a series of diagnostic information
@@ -131,11 +165,27 @@ func NewDiagnosticsAddErrorMsg(format string, args ...any) *Diagnostics {
 	return NewDiagnostics().AddErrorMsg(format, args...)
 }
 
+// AddError The error wrapped by NewRetryableError or NewThrottledError keep its class
 func (x *Diagnostics) AddError(err error) *Diagnostics {
 	if err == nil {
 		return x
 	}
-	return x._append(NewErrorDiagnostic(err.Error()))
+	return x._append(NewErrorDiagnostic(err.Error()).WithErrorClass(GetErrorClass(err)))
+}
+
+// AddRetryableErrorMsg The error is transient, retry may succeed
+func (x *Diagnostics) AddRetryableErrorMsg(format string, args ...any) *Diagnostics {
+	return x._append(NewErrorDiagnostic(fmt.Sprintf(format, args...)).WithErrorClass(ErrorClassRetryable))
+}
+
+// AddThrottledErrorMsg The request is throttled by the API, retry after a longer backoff may succeed
+func (x *Diagnostics) AddThrottledErrorMsg(format string, args ...any) *Diagnostics {
+	return x._append(NewErrorDiagnostic(fmt.Sprintf(format, args...)).WithErrorClass(ErrorClassThrottled))
+}
+
+// AddAuthErrorMsg The credentials of the client are invalid, retry will not help
+func (x *Diagnostics) AddAuthErrorMsg(format string, args ...any) *Diagnostics {
+	return x._append(NewErrorDiagnostic(fmt.Sprintf(format, args...)).WithErrorClass(ErrorClassAuth))
 }
 
 func (x *Diagnostics) AddFatal(format string, args ...any) *Diagnostics {
@@ -174,6 +224,63 @@ func (x *Diagnostics) HasError() bool {
 	return x.hasError
 }
 
+// IsRetryable Whether there is error, and all errors are retryable or throttled
+func (x *Diagnostics) IsRetryable() bool {
+	if x == nil || !x.hasError {
+		return false
+	}
+	for _, diagnostic := range x.diagnostics {
+		if diagnostic.Level() < DiagnosisLevelError {
+			continue
+		}
+		if diagnostic.Level() == DiagnosisLevelFatal || (diagnostic.ErrorClass() != ErrorClassRetryable && diagnostic.ErrorClass() != ErrorClassThrottled) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsThrottled Whether any error is caused by throttling
+func (x *Diagnostics) IsThrottled() bool {
+	if x == nil {
+		return false
+	}
+	for _, diagnostic := range x.diagnostics {
+		if diagnostic.Level() >= DiagnosisLevelError && diagnostic.ErrorClass() == ErrorClassThrottled {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAuthFailed Whether any error is caused by the invalid credentials of the client
+func (x *Diagnostics) IsAuthFailed() bool {
+	if x == nil {
+		return false
+	}
+	for _, diagnostic := range x.diagnostics {
+		if diagnostic.Level() >= DiagnosisLevelError && diagnostic.ErrorClass() == ErrorClassAuth {
+			return true
+		}
+	}
+	return false
+}
+
+// TagClient Mark the diagnostics not marked yet are about the client, so the host can tell which client goes wrong
+func (x *Diagnostics) TagClient(client any) *Diagnostics {
+	if x == nil || client == nil {
+		return x
+	}
+	clientKey := getClientKey(client)
+	clientLabels := GetClientLabels(client)
+	for _, diagnostic := range x.diagnostics {
+		if diagnostic.clientKey == "" {
+			diagnostic.WithClient(clientKey, clientLabels)
+		}
+	}
+	return x
+}
+
 func (x *Diagnostics) String() string {
 	return x.ToString()
 }
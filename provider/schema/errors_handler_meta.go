@@ -26,9 +26,22 @@ type ErrorsHandlerMeta struct {
 	// You can configure which types of errors are blocked from users
 	IgnoredErrors []IgnoredError
 
+	// After how many auth failures in a row the remaining tasks of the client are skipped, the auth failure is the error diagnostics
+	// with ErrorClassAuth, see Diagnostics.AddAuthErrorMsg and NewAuthError, 0 means DefaultClientAuthFailureThreshold,
+	// a negative value means never skip the client
+	ClientAuthFailureThreshold int
+
 	runtime *ErrorsHandlerMetaRuntime
 }
 
 func (x *ErrorsHandlerMeta) IsIgnore(err IgnoredError) bool {
 	return x.runtime.IsNeedIgnore(err)
 }
+
+// GetClientAuthFailureThreshold The threshold used by the ClientCircuitBreaker, less than or equal to 0 means never open
+func (x *ErrorsHandlerMeta) GetClientAuthFailureThreshold() int {
+	if x.ClientAuthFailureThreshold == 0 {
+		return DefaultClientAuthFailureThreshold
+	}
+	return x.ClientAuthFailureThreshold
+}
@@ -0,0 +1,180 @@
+package schema
+
+import (
+	"context"
+	"os"
+	"runtime/metrics"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultMaxMemoryMB The memory limit used when it is neither configured nor can be detected from the cgroup
+var DefaultMaxMemoryMB uint64 = 512
+
+// MemoryLimitCgroupRatio When the limit comes from the cgroup, only use this ratio of it, leave some room for the rest of the process
+const MemoryLimitCgroupRatio = 0.8
+
+// DefaultResultChannelBufferSize The largest buffer size of the channel that the data source send results to,
+// the table can set its own by TableOptions.ResultChannelBufferSize, otherwise it adapts between this and MinResultChannelBufferSize
+const DefaultResultChannelBufferSize = 10000
+
+// MinResultChannelBufferSize The smallest buffer size of the result channel, used when the memory is over the limit
+const MinResultChannelBufferSize = 10
+
+// When the usage reaches this ratio of the limit, the result channel begin to shrink
+const memoryPressureRatio = 0.8
+
+// How often to check the memory usage while waiting for it to fall back
+const memoryGovernorCheckInterval = time.Millisecond * 500
+
+// DefaultMemoryWaitTimeout The longest time a pull waits for the memory to fall back, after that the pull starts anyway with a warning,
+// the memory may be held by something that never frees it, and waiting forever makes the whole pull hang
+var DefaultMemoryWaitTimeout = time.Minute * 5
+
+// The live heap objects, same as runtime.MemStats.Alloc, but reading it does not stop the world
+const memoryUsageMetricName = "/memory/classes/heap/objects:bytes"
+
+// cgroup v2 and v1 memory limit files
+var cgroupMemoryLimitFiles = []string{
+	"/sys/fs/cgroup/memory.max",
+	"/sys/fs/cgroup/memory/memory.limit_in_bytes",
+}
+
+// MemoryGovernor Keep the memory of the pull under a limit, no more data source pull is started when it is over the limit,
+// and the result channel shrinks as the usage gets close to it
+type MemoryGovernor struct {
+	clientMeta *ClientMeta
+
+	// Always set by NewMemoryGovernor, only the zero value governor has no limit
+	limitBytes uint64
+
+	waitTimeout time.Duration
+
+	// The pulls started and not done yet, only they can free the memory while a pull is waiting
+	runningPullCount atomic.Int64
+}
+
+// NewMemoryGovernor Create a memory governor, if maxMemoryMB is 0, the limit is detected from the cgroup, and DefaultMaxMemoryMB is used if not in a container
+func NewMemoryGovernor(maxMemoryMB uint64, clientMeta *ClientMeta) *MemoryGovernor {
+	limitBytes := maxMemoryMB * 1024 * 1024
+	if limitBytes == 0 {
+		if cgroupLimit := readCgroupMemoryLimit(); cgroupLimit > 0 {
+			limitBytes = uint64(float64(cgroupLimit) * MemoryLimitCgroupRatio)
+		} else {
+			limitBytes = DefaultMaxMemoryMB * 1024 * 1024
+		}
+	}
+	return &MemoryGovernor{
+		clientMeta:  clientMeta,
+		limitBytes:  limitBytes,
+		waitTimeout: DefaultMemoryWaitTimeout,
+	}
+}
+
+// LimitBytes The memory limit in bytes
+func (x *MemoryGovernor) LimitBytes() uint64 {
+	return x.limitBytes
+}
+
+// UsageBytes The memory currently in use
+func (x *MemoryGovernor) UsageBytes() uint64 {
+	samples := []metrics.Sample{{Name: memoryUsageMetricName}}
+	metrics.Read(samples)
+	if samples[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return samples[0].Value.Uint64()
+}
+
+// IsOverLimit Whether the memory usage exceeds the limit
+func (x *MemoryGovernor) IsOverLimit() bool {
+	return x.limitBytes > 0 && x.UsageBytes() >= x.limitBytes
+}
+
+// WaitForPullStart Block until the memory usage is under the limit, then the pull is counted as running until PullDone is called.
+// The pull starts at once if no other pull is running, because nothing in the pull can free the memory then,
+// and starts with a warning if the memory does not fall back in the wait timeout. The error is returned only if the context is done
+func (x *MemoryGovernor) WaitForPullStart(ctx context.Context, taskId string) (*Diagnostics, error) {
+	diagnostics, err := x.waitForMemory(ctx, taskId)
+	if err != nil {
+		return nil, err
+	}
+	x.runningPullCount.Add(1)
+	return diagnostics, nil
+}
+
+// PullDone The pull started by WaitForPullStart is done
+func (x *MemoryGovernor) PullDone() {
+	x.runningPullCount.Add(-1)
+}
+
+func (x *MemoryGovernor) waitForMemory(ctx context.Context, taskId string) (*Diagnostics, error) {
+	if !x.IsOverLimit() {
+		return nil, nil
+	}
+	x.clientMeta.DebugF("taskId = %s, memory usage %d MB over limit %d MB, wait for it fall back", taskId, x.UsageBytes()/1024/1024, x.limitBytes/1024/1024)
+	ticker := time.NewTicker(memoryGovernorCheckInterval)
+	defer ticker.Stop()
+	var timeout <-chan time.Time
+	if x.waitTimeout > 0 {
+		timer := time.NewTimer(x.waitTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for x.IsOverLimit() && x.runningPullCount.Load() > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timeout:
+			return NewDiagnostics().AddWarn("taskId = %s, memory usage %d MB is still over limit %d MB after waiting %s, start the pull anyway", taskId, x.UsageBytes()/1024/1024, x.limitBytes/1024/1024, x.waitTimeout.String()), nil
+		case <-ticker.C:
+		}
+	}
+	return nil, nil
+}
+
+// ResultChannelBufferSize The result channel shrink as the memory usage gets close to the limit
+func (x *MemoryGovernor) ResultChannelBufferSize(defaultSize int) int {
+	if x.limitBytes == 0 {
+		return defaultSize
+	}
+	ratio := float64(x.UsageBytes()) / float64(x.limitBytes)
+	size := defaultSize
+	switch {
+	case ratio >= 1:
+		size = MinResultChannelBufferSize
+	case ratio >= memoryPressureRatio:
+		// linear shrink from the default size to the min size
+		size = int(float64(defaultSize) * (1 - ratio) / (1 - memoryPressureRatio))
+	}
+	if size < MinResultChannelBufferSize {
+		size = MinResultChannelBufferSize
+	}
+	return size
+}
+
+// Read the memory limit of the container, 0 means no limit or not in a container
+func readCgroupMemoryLimit() uint64 {
+	for _, file := range cgroupMemoryLimitFiles {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		value := strings.TrimSpace(string(content))
+		if value == "" || value == "max" {
+			return 0
+		}
+		limit, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		// cgroup v1 use a very big number as no limit
+		if limit >= 1<<62 {
+			return 0
+		}
+		return limit
+	}
+	return 0
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryGovernor(t *testing.T) {
+
+	clientMeta := &ClientMeta{}
+	_, d := NewClientMetaRuntime(context.Background(), t.TempDir(), "test", "v0.0.1", clientMeta, nil, false)
+	assert.False(t, d.HasError())
+
+	governor := NewMemoryGovernor(1024*1024, clientMeta)
+	assert.Equal(t, uint64(1024*1024*1024*1024), governor.LimitBytes())
+	assert.True(t, governor.UsageBytes() > 0)
+	assert.False(t, governor.IsOverLimit())
+	assert.Equal(t, DefaultResultChannelBufferSize, governor.ResultChannelBufferSize(DefaultResultChannelBufferSize))
+	d, err := governor.WaitForPullStart(context.Background(), "test")
+	assert.Nil(t, err)
+	assert.Nil(t, d)
+	governor.PullDone()
+
+	// always over limit
+	governor = &MemoryGovernor{clientMeta: clientMeta, limitBytes: 1}
+	assert.True(t, governor.IsOverLimit())
+	assert.Equal(t, MinResultChannelBufferSize, governor.ResultChannelBufferSize(DefaultResultChannelBufferSize))
+
+	// no pull is running, nothing can free the memory, so start at once
+	d, err = governor.WaitForPullStart(context.Background(), "test")
+	assert.Nil(t, err)
+	assert.Nil(t, d)
+
+	// a pull is running, wait for it until the context is done
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancelFunc()
+	_, err = governor.WaitForPullStart(ctx, "test")
+	assert.NotNil(t, err)
+
+	// the running pull is done, start at once
+	governor.PullDone()
+	d, err = governor.WaitForPullStart(context.Background(), "test")
+	assert.Nil(t, err)
+	assert.Nil(t, d)
+
+	// the running pull never frees the memory, start with a warning after the timeout
+	governor.waitTimeout = time.Millisecond * 100
+	d, err = governor.WaitForPullStart(context.Background(), "test")
+	assert.Nil(t, err)
+	assert.False(t, d.IsEmpty())
+	assert.False(t, d.HasError())
+}
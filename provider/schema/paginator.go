@@ -0,0 +1,287 @@
+package schema
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// PaginationType How the API tells the next page
+type PaginationType int
+
+const (
+
+	// PaginationTypeToken The page returns a token of the next page, for example the NextToken of AWS, empty means the last page
+	PaginationTypeToken PaginationType = iota
+
+	// PaginationTypeCursor The page returns a cursor or the link of the next page, for example the Link header of GitHub, empty means the last page
+	PaginationTypeCursor
+
+	// PaginationTypeOffset Request by offset and limit, the page less than the limit is the last page
+	PaginationTypeOffset
+
+	// PaginationTypePageNumber Request by page number and page size, the page less than the page size is the last page
+	PaginationTypePageNumber
+)
+
+func (x PaginationType) String() string {
+	switch x {
+	case PaginationTypeToken:
+		return "token"
+	case PaginationTypeCursor:
+		return "cursor"
+	case PaginationTypeOffset:
+		return "offset"
+	case PaginationTypePageNumber:
+		return "page_number"
+	default:
+		return "unknown"
+	}
+}
+
+// PageRequest Which page to request, only the fields of the pagination type are set
+type PageRequest struct {
+
+	// The token or cursor of the page, empty for the first page
+	Token string
+
+	Offset int64
+
+	// Begin from Paginator.FirstPageNumber
+	PageNumber int64
+
+	// The limit of the offset pagination, or the page size of the page number pagination
+	PageSize int64
+
+	// Begin from 1
+	PageIndex int
+}
+
+// Page One page returned by the API
+type Page struct {
+
+	// The items of the page, if it is a slice or an array, its elements are sent to the result channel as a ResultBatch and
+	// each of them is a result, otherwise it is sent as one result, nil means the page is empty
+	Items any
+
+	// The token or cursor of the next page, empty means this is the last page, for the token and cursor pagination
+	NextToken string
+
+	// The API tells this is the last page, for example the total count is reached, for the offset and page number pagination
+	IsLastPage bool
+}
+
+// Paginator Request the pages of an API one by one and send the items to the result channel, it takes care of the cancellation,
+// the retry of a page, the max pages and the checkpoint of the next page
+type Paginator struct {
+
+	// How the API tells the next page
+	Type PaginationType
+
+	// Request one page, return the error of the class retryable or throttled to retry the page, see NewRetryableError
+	FetchPage func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, error)
+
+	// The limit of the offset pagination, or the page size of the page number pagination, if not set, use DefaultPageSize
+	PageSize int64
+
+	// The number of the first page of the page number pagination, some API begin from 0, if not set, it is 1
+	FirstPageNumber *int64
+
+	// Stop after so many pages, 0 means no limit, a warning is reported if there are more pages
+	MaxPages int
+
+	// How to retry the failed page, only the page is requested again, nil means no retry of the page
+	RetryPolicy *RetryPolicy
+
+	// Save the next page as the checkpoint cursor of the task after the items of each page are handled, so the resumed pull begins from the next page
+	IsCheckpointEnabled bool
+}
+
+// DefaultPageSize The page size of the offset and page number pagination if it is not set
+const DefaultPageSize = 100
+
+// DataSourcePaginated The data source pulls the pages by the paginator
+func DataSourcePaginated(paginator *Paginator) DataSource {
+	return DataSource{
+		Pull: paginator.Pull,
+	}
+}
+
+// Pull Request all the pages and send their items to the result channel, it can also be called in a hand-written Pull,
+// for example once for each region
+func (x *Paginator) Pull(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+
+	diagnostics := NewDiagnostics()
+
+	request, d := x.firstPageRequest(task)
+	if diagnostics.AddDiagnostics(d).HasError() {
+		return diagnostics
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return diagnostics
+		}
+		if x.MaxPages > 0 && request.PageIndex > x.MaxPages {
+			return diagnostics.AddWarn("table %s has more than %d pages, stop at the max pages", task.Table.TableName, x.MaxPages)
+		}
+
+		// The executor takes the rate limit token for the first request of the Pull, the other pages take their own
+		if request.PageIndex > 1 {
+			if err := task.WaitRateLimit(ctx, task.Table.GetRateLimit()); err != nil {
+				return diagnostics
+			}
+		}
+
+		page, d := x.fetchPageWithRetry(ctx, clientMeta, client, task, request)
+		if diagnostics.AddDiagnostics(d).HasError() {
+			return diagnostics
+		}
+
+		itemCount, isCancelled := x.sendItems(ctx, task, page, resultChannel)
+		if isCancelled {
+			return diagnostics
+		}
+
+		nextRequest, hasNext := x.nextPageRequest(request, page, itemCount)
+		if !hasNext {
+			return diagnostics
+		}
+
+		// The cursor is saved after the items of this page are handled, then the resumed pull begins from the next page
+		if x.IsCheckpointEnabled {
+			if err := task.EmitCheckpointCursor(ctx, resultChannel, x.formatCursor(nextRequest)); err != nil {
+				return diagnostics
+			}
+		}
+		request = nextRequest
+	}
+}
+
+func (x *Paginator) fetchPageWithRetry(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, *Diagnostics) {
+	fetchBegin := time.Now()
+	for attempt := 1; ; attempt++ {
+		page, err := x.FetchPage(ctx, clientMeta, client, task, request)
+		if err == nil {
+			if page == nil {
+				page = &Page{}
+			}
+			return page, nil
+		}
+
+		d := NewDiagnostics().AddError(err)
+		if x.RetryPolicy == nil || !d.IsRetryable() || ctx.Err() != nil || !x.RetryPolicy.IsRetryAllowed(attempt+1, time.Since(fetchBegin)) {
+			return nil, d
+		}
+		backoff := x.RetryPolicy.Backoff(attempt, d.IsThrottled())
+		clientMeta.WarnF("taskId = %s, table %s page %d attempt %d failed, retry after %s, error: %s", task.logId(), task.Table.TableName, request.PageIndex, attempt, backoff.String(), err.Error())
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, d
+		case <-timer.C:
+		}
+	}
+}
+
+// Send the items of the page as one batch, return how many items are sent, and whether it is cancelled during sending
+func (x *Paginator) sendItems(ctx context.Context, task *DataSourcePullTask, page *Page, resultChannel chan<- any) (int64, bool) {
+	if page.Items == nil {
+		return 0, false
+	}
+	itemCount := int64(1)
+	value := reflect.ValueOf(page.Items)
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		itemCount = int64(value.Len())
+	}
+	if err := task.EmitBatch(ctx, resultChannel, page.Items); err != nil {
+		return 0, true
+	}
+	return itemCount, false
+}
+
+func (x *Paginator) pageSize() int64 {
+	if x.PageSize <= 0 {
+		return DefaultPageSize
+	}
+	return x.PageSize
+}
+
+func (x *Paginator) firstPageNumber() int64 {
+	if x.FirstPageNumber == nil {
+		return 1
+	}
+	return *x.FirstPageNumber
+}
+
+// The first page, or the next page saved in the checkpoint by the last interrupted pull
+func (x *Paginator) firstPageRequest(task *DataSourcePullTask) (*PageRequest, *Diagnostics) {
+	request := &PageRequest{PageIndex: 1}
+	switch x.Type {
+	case PaginationTypeOffset:
+		request.PageSize = x.pageSize()
+	case PaginationTypePageNumber:
+		request.PageSize = x.pageSize()
+		request.PageNumber = x.firstPageNumber()
+	}
+	if !x.IsCheckpointEnabled {
+		return request, nil
+	}
+	cursor := task.GetCheckpointCursor()
+	if cursor == "" {
+		return request, nil
+	}
+
+	switch x.Type {
+	case PaginationTypeToken, PaginationTypeCursor:
+		request.Token = cursor
+	case PaginationTypeOffset, PaginationTypePageNumber:
+		n, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil {
+			return nil, NewDiagnostics().AddErrorMsg("table %s checkpoint cursor %s is not a valid %s pagination cursor", task.Table.TableName, cursor, x.Type.String())
+		}
+		if x.Type == PaginationTypeOffset {
+			request.Offset = n
+		} else {
+			request.PageNumber = n
+		}
+	}
+	return request, nil
+}
+
+func (x *Paginator) nextPageRequest(request *PageRequest, page *Page, itemCount int64) (*PageRequest, bool) {
+	nextRequest := &PageRequest{PageIndex: request.PageIndex + 1, PageSize: request.PageSize}
+	switch x.Type {
+	case PaginationTypeToken, PaginationTypeCursor:
+		if page.NextToken == "" || page.NextToken == request.Token {
+			return nil, false
+		}
+		nextRequest.Token = page.NextToken
+	case PaginationTypeOffset:
+		if page.IsLastPage || itemCount < request.PageSize {
+			return nil, false
+		}
+		nextRequest.Offset = request.Offset + itemCount
+	case PaginationTypePageNumber:
+		if page.IsLastPage || itemCount < request.PageSize {
+			return nil, false
+		}
+		nextRequest.PageNumber = request.PageNumber + 1
+	default:
+		return nil, false
+	}
+	return nextRequest, true
+}
+
+func (x *Paginator) formatCursor(request *PageRequest) string {
+	switch x.Type {
+	case PaginationTypeOffset:
+		return strconv.FormatInt(request.Offset, 10)
+	case PaginationTypePageNumber:
+		return strconv.FormatInt(request.PageNumber, 10)
+	default:
+		return request.Token
+	}
+}
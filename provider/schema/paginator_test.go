@@ -0,0 +1,243 @@
+package schema
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+// Pull by the paginator directly, return the results
+func runTestPaginator(t *testing.T, paginator *Paginator, task *DataSourcePullTask) ([]any, *Diagnostics) {
+	executor := newTestExecutor(t, 1)
+	resultChannel := make(chan any, 1000)
+	d := DataSourcePaginated(paginator).Pull(context.Background(), executor.clientMeta, nil, task, resultChannel)
+	close(resultChannel)
+	return readTestPaginatorResults(task, resultChannel), d
+}
+
+// Read the results like the executor, the items of a page are sent as a batch, the cursor is saved when it is reached
+func readTestPaginatorResults(task *DataSourcePullTask, resultChannel chan any) []any {
+	results := make([]any, 0)
+	for message := range resultChannel {
+		if marker, ok := message.(*checkpointCursorMarker); ok {
+			task.SetCheckpointCursor(marker.cursor)
+			continue
+		}
+		results = append(results, unpackResults(message)...)
+	}
+	return results
+}
+
+func TestPaginator_Token(t *testing.T) {
+	table := &Table{TableName: "test_paginator_token_table"}
+
+	// 3 pages, the second page fails once
+	failedCount := 0
+	requestedTokens := make([]string, 0)
+	paginator := &Paginator{
+		Type: PaginationTypeToken,
+		FetchPage: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, error) {
+			requestedTokens = append(requestedTokens, request.Token)
+			switch request.Token {
+			case "":
+				return &Page{Items: []string{"a", "b"}, NextToken: "token-2"}, nil
+			case "token-2":
+				if failedCount == 0 {
+					failedCount++
+					return nil, NewThrottledError(errors.New("rate exceeded"))
+				}
+				return &Page{Items: []string{"c"}, NextToken: "token-3"}, nil
+			default:
+				return &Page{Items: "d"}, nil
+			}
+		},
+		RetryPolicy: &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+	}
+	results, d := runTestPaginator(t, paginator, &DataSourcePullTask{Table: table})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, []any{"a", "b", "c", "d"}, results)
+	assert.Equal(t, []string{"", "token-2", "token-2", "token-3"}, requestedTokens)
+
+	// no retry, the error is returned with its class
+	failedCount = 0
+	paginator.RetryPolicy = nil
+	results, d = runTestPaginator(t, paginator, &DataSourcePullTask{Table: table})
+	assert.True(t, d.IsThrottled())
+	assert.Equal(t, []any{"a", "b"}, results)
+
+	// max pages
+	paginator.RetryPolicy = &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+	paginator.MaxPages = 2
+	failedCount = 1
+	results, d = runTestPaginator(t, paginator, &DataSourcePullTask{Table: table})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Contains(t, d.ToString(), "more than 2 pages")
+	assert.Equal(t, []any{"a", "b", "c"}, results)
+}
+
+func TestPaginator_OffsetAndPageNumber(t *testing.T) {
+	table := &Table{TableName: "test_paginator_offset_table"}
+
+	// 25 items
+	items := make([]int, 25)
+	for i := range items {
+		items[i] = i
+	}
+	offsets := make([]int64, 0)
+	offsetPaginator := &Paginator{
+		Type:     PaginationTypeOffset,
+		PageSize: 10,
+		FetchPage: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, error) {
+			offsets = append(offsets, request.Offset)
+			end := request.Offset + request.PageSize
+			if end > int64(len(items)) {
+				end = int64(len(items))
+			}
+			return &Page{Items: items[request.Offset:end]}, nil
+		},
+	}
+	results, d := runTestPaginator(t, offsetPaginator, &DataSourcePullTask{Table: table})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Len(t, results, 25)
+	assert.Equal(t, []int64{0, 10, 20}, offsets)
+
+	// the page number begins from 0, the API tells the last page
+	zero := int64(0)
+	pageNumbers := make([]int64, 0)
+	pageNumberPaginator := &Paginator{
+		Type:            PaginationTypePageNumber,
+		PageSize:        2,
+		FirstPageNumber: &zero,
+		FetchPage: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, error) {
+			pageNumbers = append(pageNumbers, request.PageNumber)
+			return &Page{Items: []string{fmt.Sprintf("%d-a", request.PageNumber), fmt.Sprintf("%d-b", request.PageNumber)}, IsLastPage: request.PageNumber == 2}, nil
+		},
+	}
+	results, d = runTestPaginator(t, pageNumberPaginator, &DataSourcePullTask{Table: table})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Len(t, results, 6)
+	assert.Equal(t, []int64{0, 1, 2}, pageNumbers)
+}
+
+func TestPaginator_Checkpoint(t *testing.T) {
+	table := &Table{TableName: "test_paginator_checkpoint_table"}
+	store := &memoryPullCheckpointStore{valueMap: make(map[string]string)}
+	checkpoint := NewPullCheckpoint(store, "test_paginator_checkpoint", nil)
+
+	// the pull is cancelled during the third page
+	ctx, cancel := context.WithCancel(context.Background())
+	isCancelled := false
+	paginator := &Paginator{
+		Type:                PaginationTypePageNumber,
+		PageSize:            1,
+		IsCheckpointEnabled: true,
+		FetchPage: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, error) {
+			if request.PageNumber == 3 && !isCancelled {
+				isCancelled = true
+				cancel()
+				return nil, ctx.Err()
+			}
+			return &Page{Items: []string{strconv.FormatInt(request.PageNumber, 10)}, IsLastPage: request.PageNumber == 4}, nil
+		},
+	}
+	task := &DataSourcePullTask{Table: table, checkpoint: checkpoint, checkpointKey: table.TableName}
+	resultChannel := make(chan any, 10)
+	paginator.Pull(ctx, nil, nil, task, resultChannel)
+	// the cursor is not saved until the items before it are handled
+	assert.Equal(t, "", task.GetCheckpointCursor())
+	close(resultChannel)
+	assert.Equal(t, []any{"1", "2"}, readTestPaginatorResults(task, resultChannel))
+	assert.Equal(t, "3", task.GetCheckpointCursor())
+
+	// resumed from the third page
+	results, d := runTestPaginator(t, paginator, task)
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, []any{"3", "4"}, results)
+
+	// the cursor is not valid
+	checkpoint.SetCursor(table.TableName, "page-3")
+	_, d = runTestPaginator(t, paginator, task)
+	assert.True(t, d.HasError())
+}
+
+func TestPaginator_CheckpointResumeInPage(t *testing.T) {
+	store := &memoryPullCheckpointStore{valueMap: make(map[string]string)}
+
+	// the pull crashes when the first item of the second page is handled, the third page is already fetched at that time
+	isCrashed := false
+	thirdPageFetched := make(chan struct{}, 1)
+	fetchedPageNumbers := make([]int64, 0)
+	fetchLock := sync.Mutex{}
+	table := &Table{
+		TableName: "test_paginator_resume_in_page_table",
+		DataSource: DataSourcePaginated(&Paginator{
+			Type:                PaginationTypePageNumber,
+			PageSize:            2,
+			IsCheckpointEnabled: true,
+			FetchPage: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, request *PageRequest) (*Page, error) {
+				fetchLock.Lock()
+				fetchedPageNumbers = append(fetchedPageNumbers, request.PageNumber)
+				fetchLock.Unlock()
+				if request.PageNumber == 3 {
+					select {
+					case thirdPageFetched <- struct{}{}:
+					default:
+					}
+				}
+				items := []string{fmt.Sprintf("%d-a", request.PageNumber), fmt.Sprintf("%d-b", request.PageNumber)}
+				return &Page{Items: items, IsLastPage: request.PageNumber == 3}, nil
+			},
+		}),
+	}
+
+	runPull := func(resume bool) []any {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		handledResults := make([]any, 0)
+		executor := newTestExecutor(t, 1)
+		executor.clientMeta.runtime.client = []any{"client-a"}
+		checkpoint := NewPullCheckpoint(store, "test_paginator_resume", executor.clientMeta)
+		if resume {
+			assert.False(t, checkpoint.Load(ctx).HasError())
+		}
+		executor.SetCheckpoint(checkpoint)
+		executor.Submit(ctx, &DataSourcePullTask{
+			TaskId: id_util.RandomId(),
+			Ctx:    ctx,
+			Table:  table,
+			ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+				if result == "2-a" && !isCrashed {
+					isCrashed = true
+					select {
+					case <-thirdPageFetched:
+					case <-time.After(time.Second * 5):
+					}
+					cancel()
+				}
+				handledResults = append(handledResults, result)
+				rows := NewRows("item")
+				_ = rows.AppendRowValues([]any{result})
+				return rows, []any{result}, nil
+			},
+			DiagnosticsChannel: make(chan *Diagnostics, 100),
+			IsRootTask:         true,
+		})
+		executor.ShutdownAndAwaitTermination(context.Background())
+		return handledResults
+	}
+
+	assert.Equal(t, []any{"1-a", "1-b", "2-a"}, runPull(false))
+	assert.Equal(t, []int64{1, 2, 3}, fetchedPageNumbers)
+
+	// the items of the second page after the crash are not skipped
+	fetchedPageNumbers = make([]int64, 0)
+	assert.Equal(t, []any{"2-a", "2-b", "3-a", "3-b"}, runPull(true))
+	assert.Equal(t, []int64{2, 3}, fetchedPageNumbers)
+}
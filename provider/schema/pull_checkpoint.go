@@ -0,0 +1,283 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PullCheckpointStore Where the checkpoint is saved, storage.KeyValueExecutor can be used directly
+type PullCheckpointStore interface {
+	SetKey(ctx context.Context, key, value string) *Diagnostics
+
+	GetValue(ctx context.Context, key string) (string, *Diagnostics)
+
+	DeleteKey(ctx context.Context, key string) *Diagnostics
+}
+
+// PullCheckpointState The progress saved in the store
+type PullCheckpointState struct {
+	CompletedRootTables map[string]bool   `json:"completed_root_tables"`
+	CompletedExpansions map[string]bool   `json:"completed_expansions"`
+	Cursors             map[string]string `json:"cursors"`
+	UpdatedAt           time.Time         `json:"updated_at"`
+}
+
+func newPullCheckpointState() *PullCheckpointState {
+	return &PullCheckpointState{
+		CompletedRootTables: make(map[string]bool),
+		CompletedExpansions: make(map[string]bool),
+		Cursors:             make(map[string]string),
+	}
+}
+
+// The saving happens when the pull may be cancelled, so it does not use the pull context
+const pullCheckpointSaveTimeout = time.Second * 10
+
+// The cursor changes very often, it is saved at most once per this interval, the completion is always saved at once
+const pullCheckpointCursorSaveInterval = time.Second
+
+// PullCheckpoint Track and save the progress of a pull, so that an interrupted pull can resume from where it stopped.
+// The completed root tables and client expansions are skipped, the expansion is identified by its index, so the clients must keep the same order
+type PullCheckpoint struct {
+	store      PullCheckpointStore
+	key        string
+	clientMeta *ClientMeta
+
+	lock  sync.Mutex
+	state *PullCheckpointState
+
+	// The unfinished tasks of each level, <rootTableName or expansionKey, count>
+	rootTableTaskCountMap map[string]int
+	expansionTaskCountMap map[string]int
+
+	// The levels that some task is cut off, they will not be completed in this pull
+	cutOffRootTableSet map[string]bool
+	cutOffExpansionSet map[string]bool
+
+	lastSaveTime time.Time
+
+	// The state is copied with the version under the lock, and written to the store after the lock is released,
+	// so the tasks are not blocked by the store. The writes are in order, the copy older than the written one is dropped
+	saveVersion    int64
+	writeLock      sync.Mutex
+	writtenVersion int64
+	isCleared      bool
+}
+
+// A copy of the state to be written to the store
+type pullCheckpointSnapshot struct {
+	version int64
+	value   []byte
+	err     error
+}
+
+func NewPullCheckpoint(store PullCheckpointStore, key string, clientMeta *ClientMeta) *PullCheckpoint {
+	return &PullCheckpoint{
+		store:                 store,
+		key:                   key,
+		clientMeta:            clientMeta,
+		state:                 newPullCheckpointState(),
+		rootTableTaskCountMap: make(map[string]int),
+		expansionTaskCountMap: make(map[string]int),
+		cutOffRootTableSet:    make(map[string]bool),
+		cutOffExpansionSet:    make(map[string]bool),
+	}
+}
+
+// Load Read the progress of the last interrupted pull, so the completed work is skipped
+func (x *PullCheckpoint) Load(ctx context.Context) *Diagnostics {
+	value, d := x.store.GetValue(ctx, x.key)
+	if d != nil && d.HasError() {
+		return d
+	}
+	if value == "" {
+		return NewDiagnostics().AddInfo("no checkpoint found, pull from scratch")
+	}
+	state := newPullCheckpointState()
+	if err := json.Unmarshal([]byte(value), state); err != nil {
+		return NewDiagnostics().AddWarn("checkpoint %s is broken, pull from scratch: %s", x.key, err.Error())
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	// json null map
+	if state.CompletedRootTables == nil {
+		state.CompletedRootTables = make(map[string]bool)
+	}
+	if state.CompletedExpansions == nil {
+		state.CompletedExpansions = make(map[string]bool)
+	}
+	if state.Cursors == nil {
+		state.Cursors = make(map[string]string)
+	}
+	x.state = state
+	return NewDiagnostics().AddInfo("resume from checkpoint saved at %s, %d root tables completed", state.UpdatedAt.Format(time.RFC3339), len(state.CompletedRootTables))
+}
+
+// Clear The pull run to the end, the checkpoint is not needed any more, the progress is not saved after that
+func (x *PullCheckpoint) Clear(ctx context.Context) *Diagnostics {
+	x.writeLock.Lock()
+	defer x.writeLock.Unlock()
+
+	x.isCleared = true
+	return x.store.DeleteKey(ctx, x.key)
+}
+
+func (x *PullCheckpoint) IsRootTableCompleted(tableName string) bool {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.state.CompletedRootTables[tableName]
+}
+
+func (x *PullCheckpoint) IsExpansionCompleted(expansionKey string) bool {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.state.CompletedExpansions[expansionKey]
+}
+
+// GetCursor The cursor saved for the task, empty if none
+func (x *PullCheckpoint) GetCursor(taskKey string) string {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.state.Cursors[taskKey]
+}
+
+// SetCursor Save the cursor of the task
+func (x *PullCheckpoint) SetCursor(taskKey, cursor string) *Diagnostics {
+	x.lock.Lock()
+	x.state.Cursors[taskKey] = cursor
+	if time.Since(x.lastSaveTime) < pullCheckpointCursorSaveInterval {
+		x.lock.Unlock()
+		return nil
+	}
+	snapshot := x.snapshot()
+	x.lock.Unlock()
+
+	return x.write(snapshot)
+}
+
+// Save Save the progress now, include the cursors not saved yet because of the save interval
+func (x *PullCheckpoint) Save() *Diagnostics {
+	x.lock.Lock()
+	snapshot := x.snapshot()
+	x.lock.Unlock()
+
+	return x.write(snapshot)
+}
+
+// A task is submitted to the executor, it must be called before the parent task is done
+func (x *PullCheckpoint) taskSubmitted(task *DataSourcePullTask) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if rootTable := task.RootTable(); rootTable != nil {
+		x.rootTableTaskCountMap[rootTable.TableName]++
+	}
+	if task.checkpointExpansionKey != "" {
+		x.expansionTaskCountMap[task.checkpointExpansionKey]++
+	}
+}
+
+// A task is done or dropped, if it is the last one of an expansion or a root table, the level is completed
+func (x *PullCheckpoint) taskDone(task *DataSourcePullTask, isCutOff bool) *Diagnostics {
+	x.lock.Lock()
+	if !x.markTaskDone(task, isCutOff) {
+		x.lock.Unlock()
+		return nil
+	}
+	snapshot := x.snapshot()
+	x.lock.Unlock()
+
+	return x.write(snapshot)
+}
+
+// Must be called with lock held, return whether any level is completed
+func (x *PullCheckpoint) markTaskDone(task *DataSourcePullTask, isCutOff bool) bool {
+	isChanged := false
+
+	if expansionKey := task.checkpointExpansionKey; expansionKey != "" {
+		if isCutOff {
+			x.cutOffExpansionSet[expansionKey] = true
+		}
+		x.expansionTaskCountMap[expansionKey]--
+		if x.expansionTaskCountMap[expansionKey] <= 0 && !x.cutOffExpansionSet[expansionKey] {
+			delete(x.expansionTaskCountMap, expansionKey)
+			x.state.CompletedExpansions[expansionKey] = true
+			x.deleteCursors(expansionKey)
+			isChanged = true
+		}
+	}
+
+	if rootTable := task.RootTable(); rootTable != nil {
+		tableName := rootTable.TableName
+		if isCutOff {
+			x.cutOffRootTableSet[tableName] = true
+		}
+		x.rootTableTaskCountMap[tableName]--
+		if x.rootTableTaskCountMap[tableName] <= 0 && !x.cutOffRootTableSet[tableName] {
+			delete(x.rootTableTaskCountMap, tableName)
+			x.state.CompletedRootTables[tableName] = true
+			// the expansions are covered by the root table
+			for expansionKey := range x.state.CompletedExpansions {
+				if strings.HasPrefix(expansionKey, tableName+pullCheckpointKeySeparator) {
+					delete(x.state.CompletedExpansions, expansionKey)
+				}
+			}
+			isChanged = true
+		}
+	}
+
+	return isChanged
+}
+
+func (x *PullCheckpoint) deleteCursors(keyPrefix string) {
+	for taskKey := range x.state.Cursors {
+		if taskKey == keyPrefix || strings.HasPrefix(taskKey, keyPrefix+pullCheckpointKeySeparator) {
+			delete(x.state.Cursors, taskKey)
+		}
+	}
+}
+
+// Copy the state to save, must be called with lock held
+func (x *PullCheckpoint) snapshot() *pullCheckpointSnapshot {
+	x.state.UpdatedAt = time.Now()
+	x.lastSaveTime = time.Now()
+	x.saveVersion++
+	marshal, err := json.Marshal(x.state)
+	return &pullCheckpointSnapshot{version: x.saveVersion, value: marshal, err: err}
+}
+
+// Write the copy of the state to the store, must be called without lock held
+func (x *PullCheckpoint) write(snapshot *pullCheckpointSnapshot) *Diagnostics {
+	if snapshot.err != nil {
+		return NewDiagnostics().AddErrorMsg("marshal pull checkpoint error: %s", snapshot.err.Error())
+	}
+
+	x.writeLock.Lock()
+	defer x.writeLock.Unlock()
+
+	// a newer copy is written already, or the checkpoint is not needed any more
+	if snapshot.version <= x.writtenVersion || x.isCleared {
+		return nil
+	}
+	ctx, cancelFunc := context.WithTimeout(context.Background(), pullCheckpointSaveTimeout)
+	defer cancelFunc()
+	d := x.store.SetKey(ctx, x.key, string(snapshot.value))
+	if d != nil && d.HasError() {
+		x.clientMeta.ErrorF("save pull checkpoint %s error: %s", x.key, d.ToString())
+		return d
+	}
+	x.writtenVersion = snapshot.version
+	return d
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// The separator between the parts of the checkpoint key of a task
+const pullCheckpointKeySeparator = "/"
@@ -0,0 +1,149 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+// A key value store in memory
+type memoryPullCheckpointStore struct {
+	lock     sync.Mutex
+	valueMap map[string]string
+}
+
+func (x *memoryPullCheckpointStore) SetKey(ctx context.Context, key, value string) *Diagnostics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	x.valueMap[key] = value
+	return nil
+}
+
+func (x *memoryPullCheckpointStore) GetValue(ctx context.Context, key string) (string, *Diagnostics) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	return x.valueMap[key], nil
+}
+
+func (x *memoryPullCheckpointStore) DeleteKey(ctx context.Context, key string) *Diagnostics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	delete(x.valueMap, key)
+	return nil
+}
+
+func TestPullCheckpoint_Resume(t *testing.T) {
+
+	store := &memoryPullCheckpointStore{valueMap: make(map[string]string)}
+
+	// two clients, the first one finish quickly, the second one save a cursor and then hang until cancelled
+	pulledClients := make(map[string]string)
+	pulledClientsLock := sync.Mutex{}
+	table := &Table{
+		TableName: "test_checkpoint_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				pulledClientsLock.Lock()
+				pulledClients[client.(string)] = task.GetCheckpointCursor()
+				pulledClientsLock.Unlock()
+				if client == "client-b" && task.GetCheckpointCursor() == "" {
+					task.SetCheckpointCursor("page-2")
+					<-ctx.Done()
+				}
+				return nil
+			},
+		},
+	}
+
+	runPull := func(ctx context.Context, resume bool) {
+		executor := newTestExecutor(t, 2)
+		executor.clientMeta.runtime.client = []any{"client-a", "client-b"}
+		checkpoint := NewPullCheckpoint(store, "test_checkpoint", executor.clientMeta)
+		if resume {
+			assert.False(t, checkpoint.Load(ctx).HasError())
+		}
+		executor.SetCheckpoint(checkpoint)
+		if !checkpoint.IsRootTableCompleted(table.TableName) {
+			executor.Submit(ctx, &DataSourcePullTask{
+				TaskId:             id_util.RandomId(),
+				Ctx:                ctx,
+				Table:              table,
+				DiagnosticsChannel: make(chan *Diagnostics, 100),
+				IsRootTask:         true,
+			})
+		}
+		executor.ShutdownAndAwaitTermination(ctx)
+	}
+
+	// the first pull is cut off
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Millisecond*300)
+	defer cancelFunc()
+	runPull(ctx, false)
+	assert.Equal(t, map[string]string{"client-a": "", "client-b": ""}, pulledClients)
+	assert.NotEmpty(t, store.valueMap["test_checkpoint"])
+
+	// resume, only the second client is pulled again, from the cursor
+	pulledClients = make(map[string]string)
+	runPull(context.Background(), true)
+	assert.Equal(t, map[string]string{"client-b": "page-2"}, pulledClients)
+
+	// all completed, nothing to pull
+	pulledClients = make(map[string]string)
+	runPull(context.Background(), true)
+	assert.Empty(t, pulledClients)
+}
+
+// A key value store whose writes hang until released
+type blockingPullCheckpointStore struct {
+	memoryPullCheckpointStore
+	writing chan struct{}
+	release chan struct{}
+}
+
+func (x *blockingPullCheckpointStore) SetKey(ctx context.Context, key, value string) *Diagnostics {
+	x.writing <- struct{}{}
+	<-x.release
+	return x.memoryPullCheckpointStore.SetKey(ctx, key, value)
+}
+
+func TestPullCheckpoint_WriteWithoutLock(t *testing.T) {
+
+	store := &blockingPullCheckpointStore{
+		memoryPullCheckpointStore: memoryPullCheckpointStore{valueMap: make(map[string]string)},
+		writing:                   make(chan struct{}),
+		release:                   make(chan struct{}),
+	}
+	checkpoint := NewPullCheckpoint(store, "test_checkpoint", &ClientMeta{})
+
+	saveDone := make(chan *Diagnostics)
+	go func() {
+		saveDone <- checkpoint.Save()
+	}()
+	<-store.writing
+
+	// the store is slow, the tasks can still read the progress
+	readDone := make(chan struct{})
+	go func() {
+		assert.Equal(t, "", checkpoint.GetCursor("task-a"))
+		assert.False(t, checkpoint.IsRootTableCompleted("test_checkpoint_table"))
+		close(readDone)
+	}()
+	select {
+	case <-readDone:
+	case <-time.After(time.Second * 5):
+		t.Fatal("the checkpoint is locked while writing to the store")
+	}
+
+	close(store.release)
+	assert.Nil(t, <-saveDone)
+	assert.NotEmpty(t, store.valueMap["test_checkpoint"])
+
+	// no more writes after cleared
+	assert.Nil(t, checkpoint.Clear(context.Background()))
+	assert.Nil(t, checkpoint.Save())
+	assert.Empty(t, store.valueMap)
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"crypto/md5"
+	"encoding/json"
+
+	"github.com/selefra/selefra-utils/pkg/reflect_util"
+)
+
+// MaxDeduplicatedResultsPerPull How many results of a pull are remembered for the retry, the results after that are not checked
+var MaxDeduplicatedResultsPerPull = 1000000
+
+// Drop the results emitted again by the retry of a pull, the result is identified by its json content and counted,
+// because the same result may appear more than once in one attempt
+type pullResultDeduplicator struct {
+	clientMeta *ClientMeta
+	task       *DataSourcePullTask
+
+	// <fingerprint, max count emitted in any attempt>
+	emittedCountMap map[[md5.Size]byte]int
+
+	// <fingerprint, count in current attempt>
+	attemptCountMap map[[md5.Size]byte]int
+
+	// Only warn once for each pull
+	isUnmarshalableWarned bool
+	isFullWarned          bool
+}
+
+func newPullResultDeduplicator(clientMeta *ClientMeta, task *DataSourcePullTask) *pullResultDeduplicator {
+	return &pullResultDeduplicator{
+		clientMeta:      clientMeta,
+		task:            task,
+		emittedCountMap: make(map[[md5.Size]byte]int),
+		attemptCountMap: make(map[[md5.Size]byte]int),
+	}
+}
+
+func (x *pullResultDeduplicator) beginAttempt() {
+	x.attemptCountMap = make(map[[md5.Size]byte]int)
+}
+
+// Whether the result should be emitted, it is not safe for concurrent use
+func (x *pullResultDeduplicator) isNew(result any) bool {
+	// nil result is dropped by the consumer, no need to count
+	if reflect_util.IsNil(result) {
+		return true
+	}
+	fingerprint, err := resultFingerprint(result)
+	if err != nil {
+		if !x.isUnmarshalableWarned {
+			x.isUnmarshalableWarned = true
+			x.clientMeta.WarnF("taskId = %s, table %s result can not be identified for the retry, it may be duplicated: %s", x.task.logId(), x.task.Table.TableName, err.Error())
+		}
+		return true
+	}
+	if _, exists := x.emittedCountMap[fingerprint]; !exists && len(x.emittedCountMap) >= MaxDeduplicatedResultsPerPull {
+		if !x.isFullWarned {
+			x.isFullWarned = true
+			x.clientMeta.WarnF("taskId = %s, table %s has %d results remembered for the retry, the later results are not checked", x.task.logId(), x.task.Table.TableName, MaxDeduplicatedResultsPerPull)
+		}
+		return true
+	}
+	x.attemptCountMap[fingerprint]++
+	if x.attemptCountMap[fingerprint] <= x.emittedCountMap[fingerprint] {
+		return false
+	}
+	x.emittedCountMap[fingerprint] = x.attemptCountMap[fingerprint]
+	return true
+}
+
+// Identify a result by its content
+func resultFingerprint(result any) ([md5.Size]byte, error) {
+	marshal, err := json.Marshal(result)
+	if err != nil {
+		return [md5.Size]byte{}, err
+	}
+	return md5.Sum(marshal), nil
+}
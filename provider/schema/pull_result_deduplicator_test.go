@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullResultDeduplicator(t *testing.T) {
+	executor := newTestExecutor(t, 1)
+	task := &DataSourcePullTask{TaskId: "task-1", Table: &Table{TableName: "test_deduplicate_results_table"}}
+	deduplicator := newPullResultDeduplicator(executor.clientMeta, task)
+
+	// the first attempt emits everything, include the same result twice
+	deduplicator.beginAttempt()
+	assert.True(t, deduplicator.isNew("a"))
+	assert.True(t, deduplicator.isNew("a"))
+	assert.True(t, deduplicator.isNew("b"))
+
+	// the retry only emits what the first attempt did not
+	deduplicator.beginAttempt()
+	assert.False(t, deduplicator.isNew("a"))
+	assert.False(t, deduplicator.isNew("b"))
+	assert.False(t, deduplicator.isNew("a"))
+	assert.True(t, deduplicator.isNew("a"))
+	assert.True(t, deduplicator.isNew("c"))
+
+	// the result can not be marshaled is not identified, always emitted
+	unmarshalable := map[string]any{"func": func() {}}
+	assert.True(t, deduplicator.isNew(unmarshalable))
+	assert.True(t, deduplicator.isNew(unmarshalable))
+
+	// no more results are remembered after the max, they are always emitted
+	defer func(max int) {
+		MaxDeduplicatedResultsPerPull = max
+	}(MaxDeduplicatedResultsPerPull)
+	MaxDeduplicatedResultsPerPull = 3
+	assert.True(t, deduplicator.isNew("d"))
+	deduplicator.beginAttempt()
+	assert.True(t, deduplicator.isNew("d"))
+	assert.False(t, deduplicator.isNew("c"))
+	assert.Len(t, deduplicator.emittedCountMap, 3)
+}
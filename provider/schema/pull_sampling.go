@@ -0,0 +1,54 @@
+package schema
+
+import "sync"
+
+// PullSampling Limit how many results of each table are handled and how many child tasks are started, for the fast smoke runs,
+// every table is still pulled, but its pull is stopped as soon as it has enough results
+type PullSampling struct {
+
+	// At most how many raw results of each table are handled, the pull is cancelled after that, 0 means no limit
+	MaxResultsPerTable uint64
+
+	// At most how many child tasks of each sub table are started by one parent task, 0 means no limit
+	MaxChildTasksPerParent uint64
+
+	lock sync.Mutex
+	// <tableName, handled raw result count>
+	resultCountMap map[string]uint64
+}
+
+// NewPullSampling Return nil if there is no limit, the nil sampling accepts everything
+func NewPullSampling(maxResultsPerTable, maxChildTasksPerParent uint64) *PullSampling {
+	if maxResultsPerTable == 0 && maxChildTasksPerParent == 0 {
+		return nil
+	}
+	return &PullSampling{
+		MaxResultsPerTable:     maxResultsPerTable,
+		MaxChildTasksPerParent: maxChildTasksPerParent,
+		resultCountMap:         make(map[string]uint64),
+	}
+}
+
+// Whether one more raw result of the table of the task can be handled, it is counted if accepted
+func (x *PullSampling) acceptResult(task *DataSourcePullTask) bool {
+	if x == nil || x.MaxResultsPerTable == 0 || task.Table == nil {
+		return true
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if x.resultCountMap[task.Table.TableName] >= x.MaxResultsPerTable {
+		return false
+	}
+	x.resultCountMap[task.Table.TableName]++
+	return true
+}
+
+// Whether the parent task which has started childTaskCount child tasks of a sub table can start one more
+func (x *PullSampling) acceptChildTask(childTaskCount uint64) bool {
+	if x == nil || x.MaxChildTasksPerParent == 0 {
+		return true
+	}
+	return childTaskCount < x.MaxChildTasksPerParent
+}
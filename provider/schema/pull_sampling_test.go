@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullSampling(t *testing.T) {
+
+	executor := newTestExecutor(t, 2)
+	executor.SetSampling(NewPullSampling(3, 2))
+
+	var isPullStopped atomic.Bool
+	var subPullCount int32
+	subTable := &Table{
+		TableName: "test_sampling_sub_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				atomic.AddInt32(&subPullCount, 1)
+				for i := 0; i < 10; i++ {
+					resultChannel <- i
+				}
+				return nil
+			},
+		},
+	}
+	rootTable := &Table{
+		TableName: "test_sampling_root_table",
+		SubTables: []*Table{subTable},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				// an endless pull, it must be stopped by the sampling
+				for i := 0; ; i++ {
+					select {
+					case <-ctx.Done():
+						isPullStopped.Store(true)
+						return NewDiagnostics().AddError(ctx.Err())
+					case resultChannel <- i:
+					}
+				}
+			},
+		},
+	}
+
+	rowCountMap := make(map[string]int)
+	rowCountLock := sync.Mutex{}
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  rootTable,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			rowCountLock.Lock()
+			rowCountMap[task.Table.TableName]++
+			rowCountLock.Unlock()
+			rows := NewRows("value")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: diagnosticsChannel,
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+	close(diagnosticsChannel)
+
+	assert.True(t, isPullStopped.Load())
+	// each table has at most 3 results, the 3 parent rows start at most 2 child tasks each
+	assert.Equal(t, map[string]int{rootTable.TableName: 3, subTable.TableName: 3}, rowCountMap)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&subPullCount))
+
+	// the cancellation caused by the sampling is not an error
+	for d := range diagnosticsChannel {
+		if d != nil {
+			assert.False(t, d.HasError(), d.ToString())
+		}
+	}
+
+	// no limit
+	assert.Nil(t, NewPullSampling(0, 0))
+	assert.True(t, (*PullSampling)(nil).acceptResult(&DataSourcePullTask{Table: rootTable}))
+	assert.True(t, (*PullSampling)(nil).acceptChildTask(100))
+}
@@ -0,0 +1,290 @@
+package schema
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// PullCounters The counters of a table or a client
+type PullCounters struct {
+
+	// How many data source pull tasks are run, include the expanded client tasks
+	TaskCount int64 `json:"task_count"`
+
+	// How many results are sent to the result channel by the DataSource.Pull
+	RawResultCount int64 `json:"raw_result_count"`
+
+	// The results that produce no row, because they are nil, failed to handle, or received after the pull is cancelled
+	DroppedResultCount int64 `json:"dropped_result_count"`
+
+	// How many rows are saved
+	RowCount int64 `json:"row_count"`
+
+	// The errors reported to the host
+	ErrorCount int64 `json:"error_count"`
+
+	// The errors ignored because of the ErrorsHandlerMeta
+	IgnoredErrorCount int64 `json:"ignored_error_count"`
+
+	// How many times the DataSource.Pull is retried
+	RetryCount int64 `json:"retry_count"`
+
+	// The tasks not run because the circuit of the client is open
+	SkippedTaskCount int64 `json:"skipped_task_count"`
+
+	// From the first task begin to the last task end
+	Duration time.Duration `json:"duration"`
+
+	// How long the DataSource.Pull is blocked on the full result channel, that is the result handler is slower than the Pull,
+	// only the results sent by DataSourcePullTask.Emit and DataSourcePullTask.EmitBatch are measured
+	ResultChannelBlockedTime time.Duration `json:"result_channel_blocked_time"`
+
+	// The rows dropped because the rows with the same primary keys are already saved, see TableOptions.DeduplicateRows
+	DuplicateRowCount int64 `json:"duplicate_row_count"`
+}
+
+// PullClientStatistics The statistics of a client of a table
+type PullClientStatistics struct {
+	PullCounters
+
+	// The identity of the client, see RateLimitClient, the value client that can not be told apart has the index of its expansion after #
+	ClientKey string `json:"client_key"`
+
+	// The labels of the client, see LabeledClient
+	ClientLabels map[string]string `json:"client_labels"`
+
+	// The client is skipped after repeated auth failures, see ClientCircuitBreaker
+	IsCircuitOpen bool `json:"is_circuit_open"`
+}
+
+// PullTableStatistics The statistics of a table
+type PullTableStatistics struct {
+	PullCounters
+
+	TableName string `json:"table_name"`
+
+	// How long the table waited for the rate limit
+	ThrottleWaitTime time.Duration `json:"throttle_wait_time"`
+
+	// The table is not finished when the pull is cancelled or timeout, or the tasks of a client are skipped
+	IsCutOff bool `json:"is_cut_off"`
+
+	// <clientKey, statistics>
+	ClientStatistics map[string]*PullClientStatistics `json:"client_statistics"`
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// PullStatistics Count what happened in a pull for each table and each client of the table, like the rows saved, the time cost and the errors
+type PullStatistics struct {
+	lock sync.Mutex
+
+	// <tableName, statistics>
+	tableStatisticsMap map[string]*pullTableStatisticsCollector
+}
+
+type pullTableStatisticsCollector struct {
+	statistics    *PullTableStatistics
+	timeRange     pullTimeRange
+	clientTimeMap map[string]*pullTimeRange
+}
+
+type pullTimeRange struct {
+	begin time.Time
+	end   time.Time
+}
+
+func (x *pullTimeRange) add(begin, end time.Time) {
+	if x.begin.IsZero() || begin.Before(x.begin) {
+		x.begin = begin
+	}
+	if end.After(x.end) {
+		x.end = end
+	}
+}
+
+func (x *pullTimeRange) duration() time.Duration {
+	if x.begin.IsZero() {
+		return 0
+	}
+	return x.end.Sub(x.begin)
+}
+
+func NewPullStatistics() *PullStatistics {
+	return &PullStatistics{
+		tableStatisticsMap: make(map[string]*pullTableStatisticsCollector),
+	}
+}
+
+// Snapshot A copy of the statistics of all tables, <tableName, statistics>
+func (x *PullStatistics) Snapshot() map[string]*PullTableStatistics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	snapshot := make(map[string]*PullTableStatistics, len(x.tableStatisticsMap))
+	for tableName, collector := range x.tableStatisticsMap {
+		snapshot[tableName] = collector.snapshot()
+	}
+	return snapshot
+}
+
+// TableSnapshot A copy of the statistics of the table, nil if nothing about the table is collected
+func (x *PullStatistics) TableSnapshot(tableName string) *PullTableStatistics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	collector, exists := x.tableStatisticsMap[tableName]
+	if !exists {
+		return nil
+	}
+	return collector.snapshot()
+}
+
+// The average raw results of the done tasks of the table, false if no task of the table is done
+func (x *PullStatistics) averageResultsPerTask(tableName string) (float64, bool) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	collector, exists := x.tableStatisticsMap[tableName]
+	if !exists || collector.statistics.TaskCount == 0 {
+		return 0, false
+	}
+	return float64(collector.statistics.RawResultCount) / float64(collector.statistics.TaskCount), true
+}
+
+// SetThrottleWaitTime Record how long each table waited for the rate limit, <tableName, wait time>
+func (x *PullStatistics) SetThrottleWaitTime(throttleWaitTimeMap map[string]time.Duration) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for tableName, waitTime := range throttleWaitTimeMap {
+		x.getCollector(tableName).statistics.ThrottleWaitTime = waitTime
+	}
+}
+
+// SetCutOff Mark the table is not finished
+func (x *PullStatistics) SetCutOff(tableName string) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.getCollector(tableName).statistics.IsCutOff = true
+}
+
+// SetCircuitOpen Mark the clients are skipped after repeated auth failures, in all tables
+func (x *PullStatistics) SetCircuitOpen(clientKeys []string) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for _, collector := range x.tableStatisticsMap {
+		for _, clientKey := range clientKeys {
+			if clientStatistics, exists := collector.statistics.ClientStatistics[clientKey]; exists {
+				clientStatistics.IsCircuitOpen = true
+			}
+		}
+	}
+}
+
+// Update the counters of the table and the client of the task
+func (x *PullStatistics) update(task *DataSourcePullTask, updateFunc func(counters *PullCounters)) {
+	if task.Table == nil {
+		return
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	collector := x.getCollector(task.Table.TableName)
+	updateFunc(&collector.statistics.PullCounters)
+	updateFunc(&collector.getClientStatistics(task).PullCounters)
+}
+
+// A task run from begin to end
+func (x *PullStatistics) taskDone(task *DataSourcePullTask, begin, end time.Time) {
+	if task.Table == nil {
+		return
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	collector := x.getCollector(task.Table.TableName)
+	clientStatistics := collector.getClientStatistics(task)
+	clientKey := clientStatistics.ClientKey
+	collector.statistics.TaskCount++
+	clientStatistics.TaskCount++
+
+	collector.timeRange.add(begin, end)
+	collector.statistics.Duration = collector.timeRange.duration()
+	clientTimeRange, exists := collector.clientTimeMap[clientKey]
+	if !exists {
+		clientTimeRange = &pullTimeRange{}
+		collector.clientTimeMap[clientKey] = clientTimeRange
+	}
+	clientTimeRange.add(begin, end)
+	clientStatistics.Duration = clientTimeRange.duration()
+}
+
+// Must be called with lock held
+func (x *PullStatistics) getCollector(tableName string) *pullTableStatisticsCollector {
+	collector, exists := x.tableStatisticsMap[tableName]
+	if !exists {
+		collector = &pullTableStatisticsCollector{
+			statistics: &PullTableStatistics{
+				TableName:        tableName,
+				ClientStatistics: make(map[string]*PullClientStatistics),
+			},
+			clientTimeMap: make(map[string]*pullTimeRange),
+		}
+		x.tableStatisticsMap[tableName] = collector
+	}
+	return collector
+}
+
+func (x *pullTableStatisticsCollector) getClientStatistics(task *DataSourcePullTask) *PullClientStatistics {
+	clientKey := statisticsClientKey(task)
+	clientStatistics, exists := x.statistics.ClientStatistics[clientKey]
+	if !exists {
+		clientStatistics = &PullClientStatistics{
+			ClientKey:    clientKey,
+			ClientLabels: GetClientLabels(task.Client),
+		}
+		x.statistics.ClientStatistics[clientKey] = clientStatistics
+	}
+	return clientStatistics
+}
+
+// The client of the task in the statistics, the value clients that can not be told apart are told by the client expansion of the task,
+// for example "string#1" is the second client, so they are not merged into one
+func statisticsClientKey(task *DataSourcePullTask) string {
+	clientKey, isDistinct := getClientKeyE(task.Client)
+	if isDistinct || task.checkpointExpansionKey == "" {
+		return clientKey
+	}
+	expansionIndex := task.checkpointExpansionKey[strings.LastIndex(task.checkpointExpansionKey, pullCheckpointKeySeparator)+1:]
+	return clientKey + "#" + expansionIndex
+}
+
+func (x *pullTableStatisticsCollector) snapshot() *PullTableStatistics {
+	statistics := *x.statistics
+	statistics.ClientStatistics = make(map[string]*PullClientStatistics, len(x.statistics.ClientStatistics))
+	for clientKey, clientStatistics := range x.statistics.ClientStatistics {
+		clientStatisticsCopy := *clientStatistics
+		statistics.ClientStatistics[clientKey] = &clientStatisticsCopy
+	}
+	return &statistics
+}
+
+// The number of errors in the diagnostics
+func countDiagnosticsErrors(diagnostics *Diagnostics) int64 {
+	if diagnostics == nil || !diagnostics.HasError() {
+		return 0
+	}
+	count := int64(0)
+	for _, diagnostic := range diagnostics.GetDiagnosticSlice() {
+		if diagnostic.Level() >= DiagnosisLevelError {
+			count++
+		}
+	}
+	return count
+}
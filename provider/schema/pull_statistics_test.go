@@ -0,0 +1,154 @@
+package schema
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+type testStatisticsClient string
+
+func (x testStatisticsClient) RateLimitKey() string {
+	return string(x)
+}
+
+func TestPullStatistics(t *testing.T) {
+
+	executor := newTestExecutor(t, 2)
+	executor.clientMeta.runtime.client = []any{testStatisticsClient("client-a"), testStatisticsClient("client-b")}
+
+	var attemptCount int32
+	table := &Table{
+		TableName: "test_statistics_table",
+		Options: &TableOptions{
+			RetryPolicy: &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+		},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				if client == testStatisticsClient("client-b") {
+					// fail once, then a nil result and a result the handler drops
+					if atomic.AddInt32(&attemptCount, 1) == 1 {
+						return NewDiagnostics().AddRetryableErrorMsg("connection reset")
+					}
+					resultChannel <- nil
+					resultChannel <- "drop"
+					return NewDiagnostics().AddErrorMsg("partial failure")
+				}
+				resultChannel <- "a"
+				resultChannel <- "b"
+				return nil
+			},
+		},
+	}
+
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  table,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			if result == "drop" {
+				return nil, nil, nil
+			}
+			rows := NewRows("value")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: diagnosticsChannel,
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+
+	statistics := executor.Statistics().TableSnapshot(table.TableName)
+	assert.NotNil(t, statistics)
+	assert.Equal(t, int64(2), statistics.TaskCount)
+	assert.Equal(t, int64(4), statistics.RawResultCount)
+	assert.Equal(t, int64(2), statistics.DroppedResultCount)
+	assert.Equal(t, int64(2), statistics.RowCount)
+	assert.Equal(t, int64(1), statistics.RetryCount)
+	assert.Equal(t, int64(1), statistics.ErrorCount)
+
+	clientA := statistics.ClientStatistics["client-a"]
+	assert.Equal(t, int64(2), clientA.RowCount)
+	assert.Equal(t, int64(0), clientA.ErrorCount)
+	clientB := statistics.ClientStatistics["client-b"]
+	assert.Equal(t, int64(0), clientB.RowCount)
+	assert.Equal(t, int64(2), clientB.DroppedResultCount)
+	assert.Equal(t, int64(1), clientB.ErrorCount)
+
+	// the snapshot is a copy
+	statistics.ClientStatistics["client-a"].RowCount = 100
+	assert.Equal(t, int64(2), executor.Statistics().TableSnapshot(table.TableName).ClientStatistics["client-a"].RowCount)
+}
+
+func TestPullStatistics_IgnoredError(t *testing.T) {
+
+	executor := newTestExecutor(t, 1)
+	executor.errorsHandlerMeta.IgnoredErrors = []IgnoredError{IgnoredErrorOnPullTable}
+	executor.errorsHandlerMeta.runtime = NewErrorsHandlerMetaRuntime(executor.errorsHandlerMeta)
+
+	table := &Table{
+		TableName: "test_statistics_ignored_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				return NewDiagnostics().AddErrorMsg("error 1").AddErrorMsg("error 2")
+			},
+		},
+	}
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId:             id_util.RandomId(),
+		Ctx:                context.Background(),
+		Table:              table,
+		DiagnosticsChannel: make(chan *Diagnostics, 100),
+		IsRootTask:         true,
+		IsExpandDone:       true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+
+	statistics := executor.Statistics().TableSnapshot(table.TableName)
+	assert.Equal(t, int64(0), statistics.ErrorCount)
+	assert.Equal(t, int64(2), statistics.IgnoredErrorCount)
+}
+
+func TestPullStatistics_ValueClients(t *testing.T) {
+
+	// the string clients can not be told apart by the key, they are told by the expansion
+	executor := newTestExecutor(t, 2)
+	executor.clientMeta.runtime.client = []any{"client-a", "client-b"}
+
+	table := &Table{
+		TableName: "test_statistics_value_clients_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				resultChannel <- client
+				if client == "client-b" {
+					resultChannel <- client
+				}
+				return nil
+			},
+		},
+	}
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  table,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			rows := NewRows("value")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: make(chan *Diagnostics, 100),
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+
+	statistics := executor.Statistics().TableSnapshot(table.TableName)
+	assert.Len(t, statistics.ClientStatistics, 2)
+	assert.Equal(t, int64(1), statistics.ClientStatistics["string#0"].RowCount)
+	assert.Equal(t, int64(2), statistics.ClientStatistics["string#1"].RowCount)
+	assert.Equal(t, int64(1), statistics.ClientStatistics["string#1"].TaskCount)
+}
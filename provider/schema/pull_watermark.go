@@ -0,0 +1,261 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// PullWatermark Track the max value of TableOptions.IncrementalCursorColumn during a pull, and save them when the pull succeeds,
+// the next pull reads it by DataSourcePullTask.GetPreviousWatermark. It is kept per table and per client expansion index like the checkpoint
+type PullWatermark struct {
+	store      PullCheckpointStore
+	keyPrefix  string
+	clientMeta *ClientMeta
+
+	// Ignore the saved watermarks, the tables are pulled in full, the new watermarks are still saved
+	isFullResync bool
+
+	lock sync.Mutex
+
+	// The watermarks read from the store, <watermarkKey, value>
+	previousMap map[string]string
+
+	// The max cursor value seen in this pull, <watermarkKey, value>
+	currentMap map[string]any
+
+	// The watermarks whose pull failed or is cut off, they are not saved, otherwise the failed records would be skipped next time
+	failedSet map[string]bool
+}
+
+// NewPullWatermark Create the watermark tracker, every watermark is saved to the store with the key prefix
+func NewPullWatermark(store PullCheckpointStore, keyPrefix string, clientMeta *ClientMeta) *PullWatermark {
+	return &PullWatermark{
+		store:       store,
+		keyPrefix:   keyPrefix,
+		clientMeta:  clientMeta,
+		previousMap: make(map[string]string),
+		currentMap:  make(map[string]any),
+		failedSet:   make(map[string]bool),
+	}
+}
+
+// SetFullResync Force the incremental tables to be pulled in full, it should be set before any task is submitted
+func (x *PullWatermark) SetFullResync(isFullResync bool) *PullWatermark {
+	x.isFullResync = isFullResync
+	return x
+}
+
+// GetPrevious The watermark saved by the last successful pull, empty if none or a full resync is required
+func (x *PullWatermark) GetPrevious(ctx context.Context, watermarkKey string) string {
+	if x.isFullResync {
+		return ""
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if value, exists := x.previousMap[watermarkKey]; exists {
+		return value
+	}
+	value, d := x.store.GetValue(ctx, x.keyPrefix+watermarkKey)
+	if d != nil && d.HasError() {
+		// Not cached, so it will be read again next time
+		x.clientMeta.ErrorF("read pull watermark %s error: %s", watermarkKey, d.ToString())
+		return ""
+	}
+	x.previousMap[watermarkKey] = value
+	return value
+}
+
+// Save Save the watermarks of this pull, the watermarks which failed or have no record seen are not changed
+func (x *PullWatermark) Save(ctx context.Context) *Diagnostics {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	diagnostics := NewDiagnostics()
+	for watermarkKey, value := range x.currentMap {
+		if x.failedSet[watermarkKey] {
+			continue
+		}
+		watermark := formatWatermarkValue(value)
+		if d := x.store.SetKey(ctx, x.keyPrefix+watermarkKey, watermark); d != nil && d.HasError() {
+			diagnostics.AddDiagnostics(d)
+			continue
+		}
+		x.previousMap[watermarkKey] = watermark
+	}
+	return diagnostics
+}
+
+// The rows of the task are saved, remember the max cursor value
+func (x *PullWatermark) observe(task *DataSourcePullTask, rows *Rows) {
+	cursorColumn := task.Table.GetIncrementalCursorColumn()
+	// The targeted refresh only pulls a part of the table, the records it skipped may be older than its max cursor value
+	if cursorColumn == "" || rows == nil || task.isParameterized() {
+		return
+	}
+	watermarkKey := task.watermarkKey()
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for rowIndex := 0; rowIndex < rows.RowCount(); rowIndex++ {
+		value, err := rows.GetColumnValue(rowIndex, cursorColumn)
+		if err != nil {
+			continue
+		}
+		value, ok := normalizeWatermarkValue(value)
+		if !ok {
+			continue
+		}
+		if current, exists := x.currentMap[watermarkKey]; !exists || compareWatermarkValue(value, current) > 0 {
+			x.currentMap[watermarkKey] = value
+		}
+	}
+}
+
+// The task failed or is cut off, its watermark is not saved in this pull
+func (x *PullWatermark) taskFailed(task *DataSourcePullTask) {
+	if task.Table.GetIncrementalCursorColumn() == "" {
+		return
+	}
+	watermarkKey := task.watermarkKey()
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if !x.failedSet[watermarkKey] {
+		x.clientMeta.DebugF("taskId = %s, watermark %s will not be saved because of failure", task.TaskId, watermarkKey)
+	}
+	x.failedSet[watermarkKey] = true
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// The cursor value is one of time.Time, int64, uint64, float64 and string, others are ignored
+func normalizeWatermarkValue(value any) (any, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case time.Time:
+		return v, !v.IsZero()
+	case *time.Time:
+		if v == nil || v.IsZero() {
+			return nil, false
+		}
+		return *v, true
+	case string:
+		return v, v != ""
+	case *string:
+		if v == nil || *v == "" {
+			return nil, false
+		}
+		return *v, true
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() == reflect.Pointer {
+		if reflectValue.IsNil() {
+			return nil, false
+		}
+		reflectValue = reflectValue.Elem()
+	}
+	switch reflectValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflectValue.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return reflectValue.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return reflectValue.Float(), true
+	default:
+		return nil, false
+	}
+}
+
+// Compare two normalized cursor values, the values of different types are compared as string
+func compareWatermarkValue(a, b any) int {
+	switch av := a.(type) {
+	case time.Time:
+		if bv, ok := b.(time.Time); ok {
+			switch {
+			case av.Before(bv):
+				return -1
+			case av.After(bv):
+				return 1
+			default:
+				return 0
+			}
+		}
+	case int64:
+		switch bv := b.(type) {
+		case int64:
+			return compareOrdered(av, bv)
+		case uint64:
+			if av < 0 {
+				return -1
+			}
+			return compareOrdered(uint64(av), bv)
+		case float64:
+			return compareOrdered(float64(av), bv)
+		}
+	case uint64:
+		switch bv := b.(type) {
+		case uint64:
+			return compareOrdered(av, bv)
+		case int64:
+			if bv < 0 {
+				return 1
+			}
+			return compareOrdered(av, uint64(bv))
+		case float64:
+			return compareOrdered(float64(av), bv)
+		}
+	case float64:
+		switch bv := b.(type) {
+		case float64:
+			return compareOrdered(av, bv)
+		case int64:
+			return compareOrdered(av, float64(bv))
+		case uint64:
+			return compareOrdered(av, float64(bv))
+		}
+	case string:
+		if bv, ok := b.(string); ok {
+			return compareOrdered(av, bv)
+		}
+	}
+	return compareOrdered(formatWatermarkValue(a), formatWatermarkValue(b))
+}
+
+func compareOrdered[T int64 | uint64 | float64 | string](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// The watermark is saved as string, the time is in RFC3339Nano so it can be parsed back by DataSourcePullTask.GetPreviousWatermarkTime
+func formatWatermarkValue(value any) string {
+	switch v := value.(type) {
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
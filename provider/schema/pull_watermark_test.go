@@ -0,0 +1,170 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPullWatermark_Incremental(t *testing.T) {
+
+	store := &memoryPullCheckpointStore{valueMap: make(map[string]string)}
+	baseTime := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	// client-a has two records, client-b has one record and fails when isFailB is set
+	isFailB := false
+	previousWatermarks := make(map[string]string)
+	previousWatermarksLock := sync.Mutex{}
+	table := &Table{
+		TableName: "test_watermark_table",
+		Options: &TableOptions{
+			IncrementalCursorColumn: "event_time",
+		},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				previousWatermarksLock.Lock()
+				previousWatermarks[client.(string)] = task.GetPreviousWatermark()
+				previousWatermarksLock.Unlock()
+				switch client {
+				case "client-a":
+					resultChannel <- baseTime.Add(time.Hour * 2)
+					resultChannel <- baseTime.Add(time.Hour)
+				case "client-b":
+					if isFailB {
+						resultChannel <- baseTime.Add(time.Hour * 4)
+						return NewDiagnostics().AddErrorMsg("client-b pull failed")
+					}
+					resultChannel <- baseTime.Add(time.Hour * 3)
+				}
+				return nil
+			},
+		},
+	}
+	resultHandler := func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+		rows := NewRows("event_time")
+		if err := rows.AppendRowValues([]any{result}); err != nil {
+			return nil, nil, NewDiagnostics().AddErrorMsg(err.Error())
+		}
+		return rows, []any{result}, nil
+	}
+
+	runPull := func(isFullResync bool) {
+		executor := newTestExecutor(t, 2)
+		executor.clientMeta.runtime.client = []any{"client-a", "client-b"}
+		watermark := NewPullWatermark(store, "test_watermark_", executor.clientMeta).SetFullResync(isFullResync)
+		executor.SetWatermark(watermark)
+		executor.Submit(context.Background(), &DataSourcePullTask{
+			TaskId:             id_util.RandomId(),
+			Ctx:                context.Background(),
+			Table:              table,
+			ResultHandler:      resultHandler,
+			DiagnosticsChannel: make(chan *Diagnostics, 100),
+			IsRootTask:         true,
+		})
+		executor.ShutdownAndAwaitTermination(context.Background())
+		d := watermark.Save(context.Background())
+		assert.False(t, d.HasError())
+	}
+
+	// the first pull has no watermark
+	runPull(false)
+	assert.Equal(t, map[string]string{"client-a": "", "client-b": ""}, previousWatermarks)
+
+	// the second pull see the max cursor of each client, client-b fails this time
+	isFailB = true
+	expected := map[string]string{
+		"client-a": baseTime.Add(time.Hour * 2).Format(time.RFC3339Nano),
+		"client-b": baseTime.Add(time.Hour * 3).Format(time.RFC3339Nano),
+	}
+	runPull(false)
+	assert.Equal(t, expected, previousWatermarks)
+
+	// the failed client keeps the old watermark, the records of the failed pull are not skipped
+	isFailB = false
+	runPull(false)
+	assert.Equal(t, expected, previousWatermarks)
+
+	// a full resync ignores all of them
+	runPull(true)
+	assert.Equal(t, map[string]string{"client-a": "", "client-b": ""}, previousWatermarks)
+	assert.Len(t, store.valueMap, 2)
+}
+
+func TestPullWatermark_Parameterized(t *testing.T) {
+
+	store := &memoryPullCheckpointStore{valueMap: make(map[string]string)}
+	baseTime := time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	table := &Table{
+		TableName: "test_watermark_parameterized_table",
+		Options: &TableOptions{
+			IncrementalCursorColumn: "event_time",
+		},
+		Parameters: []*TableParameter{
+			{Name: "hours", Type: TableParameterTypeInt},
+		},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				hours := int64(1)
+				if task.GetParameters().Has("hours") {
+					hours = task.GetParameters().GetInt("hours")
+				}
+				resultChannel <- baseTime.Add(time.Hour * time.Duration(hours))
+				return nil
+			},
+		},
+	}
+
+	runPull := func(tableParameters map[string]TableParameterValues) {
+		executor := newTestExecutor(t, 2)
+		watermark := NewPullWatermark(store, "test_watermark_", executor.clientMeta)
+		executor.SetWatermark(watermark)
+		executor.SetTableParameters(tableParameters)
+		executor.Submit(context.Background(), &DataSourcePullTask{
+			TaskId: id_util.RandomId(),
+			Ctx:    context.Background(),
+			Table:  table,
+			ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+				rows := NewRows("event_time")
+				_ = rows.AppendRowValues([]any{result})
+				return rows, []any{result}, nil
+			},
+			DiagnosticsChannel: make(chan *Diagnostics, 100),
+			IsRootTask:         true,
+		})
+		executor.ShutdownAndAwaitTermination(context.Background())
+		assert.False(t, watermark.Save(context.Background()).HasError())
+	}
+
+	runPull(nil)
+	expected := make(map[string]string)
+	for key, value := range store.valueMap {
+		expected[key] = value
+	}
+	assert.Len(t, expected, 1)
+	for _, value := range expected {
+		assert.Equal(t, baseTime.Add(time.Hour).Format(time.RFC3339Nano), value)
+	}
+
+	// the targeted refresh does not move the watermark, although its record is newer
+	runPull(map[string]TableParameterValues{table.TableName: {"hours": int64(5)}})
+	assert.Equal(t, expected, store.valueMap)
+}
+
+func TestCompareWatermarkValue(t *testing.T) {
+	assert.Equal(t, 1, compareWatermarkValue(int64(10), int64(9)))
+	assert.Equal(t, -1, compareWatermarkValue(int64(-1), uint64(0)))
+	assert.Equal(t, 0, compareWatermarkValue(float64(2), int64(2)))
+	assert.Equal(t, -1, compareWatermarkValue("a", "b"))
+	assert.Equal(t, 1, compareWatermarkValue(time.Unix(2, 0), time.Unix(1, 0)))
+
+	value, ok := normalizeWatermarkValue(int32(5))
+	assert.True(t, ok)
+	assert.Equal(t, int64(5), value)
+	_, ok = normalizeWatermarkValue(nil)
+	assert.False(t, ok)
+}
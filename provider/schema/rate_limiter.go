@@ -0,0 +1,191 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// RateLimit Declare a rate limit of the API of the data source, the limit is token bucket, and is applied to each client separately,
+// so the provider does not have to implement it by itself to avoid being throttled
+type RateLimit struct {
+
+	// The requests of the same client and same scope share one bucket, for example an API name "ec2:DescribeInstances",
+	// if it is empty, the table name is used as the scope when it is declared on a table
+	Scope string
+
+	// How many requests are allowed per second on average
+	RequestsPerSecond float64
+
+	// How many requests are allowed at once, if it is less than 1, 1 is used
+	Burst int
+}
+
+// RateLimitClient The client can implement this interface to tell which identity it is, the clients with same identity share the buckets,
+// and the statistics and the circuit breaker of the pull are grouped by it too, if not implemented, the pointer address of the client is
+// the identity. A value client without it can not be told apart, so its circuit is never open, and it is told by the expansion in the statistics
+type RateLimitClient interface {
+	RateLimitKey() string
+}
+
+// RateLimiter Holds all the token buckets of a pull, and counts how long each table is throttled
+type RateLimiter struct {
+	lock sync.Mutex
+
+	// <clientKey/scope, bucket>
+	bucketMap map[string]*tokenBucket
+
+	// <tableName, throttle wait time>
+	throttleWaitTimeMap map[string]time.Duration
+}
+
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		bucketMap:           make(map[string]*tokenBucket),
+		throttleWaitTimeMap: make(map[string]time.Duration),
+	}
+}
+
+// Wait Block until the rate limit allow one more request, or the context is done
+func (x *RateLimiter) Wait(ctx context.Context, client any, table *Table, limit *RateLimit) error {
+	if limit == nil || limit.RequestsPerSecond <= 0 {
+		return nil
+	}
+
+	scope := limit.Scope
+	if scope == "" && table != nil {
+		scope = table.TableName
+	}
+	bucket := x.getBucket(getClientKey(client)+"/"+scope, limit)
+
+	waitTime := bucket.reserve(time.Now())
+	if waitTime <= 0 {
+		return nil
+	}
+
+	tableName := ""
+	if table != nil {
+		tableName = table.TableName
+	}
+	timer := time.NewTimer(waitTime)
+	defer timer.Stop()
+	begin := time.Now()
+	select {
+	case <-ctx.Done():
+		// the request is not sent, give the token back
+		bucket.cancel()
+		x.addThrottleWaitTime(tableName, time.Since(begin))
+		return ctx.Err()
+	case <-timer.C:
+		x.addThrottleWaitTime(tableName, waitTime)
+		return nil
+	}
+}
+
+// ThrottleWaitTime How long each table has been waiting for the rate limit
+func (x *RateLimiter) ThrottleWaitTime() map[string]time.Duration {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	throttleWaitTimeMap := make(map[string]time.Duration, len(x.throttleWaitTimeMap))
+	for tableName, waitTime := range x.throttleWaitTimeMap {
+		throttleWaitTimeMap[tableName] = waitTime
+	}
+	return throttleWaitTimeMap
+}
+
+func (x *RateLimiter) addThrottleWaitTime(tableName string, waitTime time.Duration) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.throttleWaitTimeMap[tableName] += waitTime
+}
+
+// The first limit decide the bucket, the same key should always use the same limit
+func (x *RateLimiter) getBucket(key string, limit *RateLimit) *tokenBucket {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	bucket, exists := x.bucketMap[key]
+	if !exists {
+		bucket = newTokenBucket(limit.RequestsPerSecond, limit.Burst)
+		x.bucketMap[key] = bucket
+	}
+	return bucket
+}
+
+// The identity of the client, used by the rate limit buckets and the statistics
+func getClientKey(client any) string {
+	clientKey, _ := getClientKeyE(client)
+	return clientKey
+}
+
+// The identity of the client, and whether it tells the client apart from the other clients of the same type,
+// the value client that does not implement RateLimitClient can not be told apart, all of them have the same key
+func getClientKeyE(client any) (string, bool) {
+	if client == nil {
+		return "", true
+	}
+	if rateLimitClient, ok := client.(RateLimitClient); ok {
+		return rateLimitClient.RateLimitKey(), true
+	}
+	reflectValue := reflect.ValueOf(client)
+	switch reflectValue.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return fmt.Sprintf("%T@%x", client, reflectValue.Pointer()), true
+	default:
+		// A value client can not be told apart, all of them share the buckets
+		return fmt.Sprintf("%T", client), false
+	}
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+type tokenBucket struct {
+	lock sync.Mutex
+
+	ratePerSecond float64
+	burst         float64
+
+	// It may be negative, means how many requests are waiting for the token
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(ratePerSecond float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+	return &tokenBucket{
+		ratePerSecond: ratePerSecond,
+		burst:         float64(burst),
+		tokens:        float64(burst),
+		lastRefill:    time.Now(),
+	}
+}
+
+// Take a token, return how long to wait before the token is available
+func (x *tokenBucket) reserve(now time.Time) time.Duration {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if elapsed := now.Sub(x.lastRefill); elapsed > 0 {
+		x.tokens = math.Min(x.burst, x.tokens+elapsed.Seconds()*x.ratePerSecond)
+		x.lastRefill = now
+	}
+	x.tokens--
+	if x.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-x.tokens / x.ratePerSecond * float64(time.Second))
+}
+
+func (x *tokenBucket) cancel() {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.tokens = math.Min(x.burst, x.tokens+1)
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testRateLimitClient struct {
+	account string
+}
+
+func (x *testRateLimitClient) RateLimitKey() string {
+	return x.account
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	rateLimiter := NewRateLimiter()
+	table := &Table{TableName: "test_rate_limit_table"}
+	limit := &RateLimit{RequestsPerSecond: 20, Burst: 1}
+	client := &testRateLimitClient{account: "a"}
+
+	begin := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, rateLimiter.Wait(context.Background(), client, table, limit))
+	}
+	// the first one use the burst, the other four wait 50ms each
+	assert.GreaterOrEqual(t, time.Since(begin), time.Millisecond*180)
+	assert.GreaterOrEqual(t, rateLimiter.ThrottleWaitTime()["test_rate_limit_table"], time.Millisecond*180)
+
+	// another client and another scope have their own buckets
+	begin = time.Now()
+	assert.Nil(t, rateLimiter.Wait(context.Background(), &testRateLimitClient{account: "b"}, table, limit))
+	assert.Nil(t, rateLimiter.Wait(context.Background(), client, table, &RateLimit{Scope: "other", RequestsPerSecond: 20}))
+	assert.Less(t, time.Since(begin), time.Millisecond*40)
+
+	// no limit
+	assert.Nil(t, rateLimiter.Wait(context.Background(), client, table, nil))
+}
+
+func TestRateLimiter_WaitCancel(t *testing.T) {
+	rateLimiter := NewRateLimiter()
+	limit := &RateLimit{RequestsPerSecond: 0.1, Burst: 1}
+	assert.Nil(t, rateLimiter.Wait(context.Background(), nil, nil, limit))
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancelFunc()
+	assert.NotNil(t, rateLimiter.Wait(ctx, nil, nil, limit))
+}
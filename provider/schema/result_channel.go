@@ -0,0 +1,137 @@
+package schema
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// InitialRootResultChannelBufferSize The buffer size of the result channel of a root task when no task of its table is done
+const InitialRootResultChannelBufferSize = 1000
+
+// InitialChildResultChannelBufferSize The buffer size of the result channel of a child task when no task of its table is done,
+// there may be a lot of child tasks running, and most of them return a few results
+const InitialChildResultChannelBufferSize = 100
+
+// Once some tasks of the table are done, the buffer can hold this many times of their average results
+const resultChannelBufferSizeFactor = 2
+
+// ResultBatch Some results sent to the result channel as one message, the executor takes out each of them and handles it as a result,
+// use DataSourcePullTask.EmitBatch to send it
+type ResultBatch []any
+
+// The buffer size of the result channel of the task, before the memory governor shrinks it,
+// it adapts to how many results the tasks of the table sent before, so a small table or a child task does not hold a large buffer
+func adaptiveResultChannelBufferSize(task *DataSourcePullTask, statistics *PullStatistics) int {
+	if size := task.Table.GetResultChannelBufferSize(); size > 0 {
+		return size
+	}
+
+	average, ok := statistics.averageResultsPerTask(task.Table.TableName)
+	if !ok {
+		if task.IsRootTask {
+			return InitialRootResultChannelBufferSize
+		}
+		return InitialChildResultChannelBufferSize
+	}
+	size := int(average*resultChannelBufferSizeFactor) + 1
+	if size < MinResultChannelBufferSize {
+		size = MinResultChannelBufferSize
+	}
+	if size > DefaultResultChannelBufferSize {
+		size = DefaultResultChannelBufferSize
+	}
+	return size
+}
+
+// Emit Send one result to the result channel, block until it is sent or the context is done, the time blocked on the full channel
+// is counted in the statistics of the table. Return the error of the context if it is done before the result is sent
+func (x *DataSourcePullTask) Emit(ctx context.Context, resultChannel chan<- any, result any) error {
+	// most of the time the channel is not full, no need to read the clock
+	select {
+	case resultChannel <- result:
+		return nil
+	default:
+	}
+
+	// the forwarder of the attempt counts the time blocked on the result channel behind it
+	if resultChannel == x.attemptChannel {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case resultChannel <- result:
+			return nil
+		}
+	}
+
+	blockBegin := time.Now()
+	defer func() {
+		x.addResultChannelBlockedTime(time.Since(blockBegin))
+	}()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case resultChannel <- result:
+		return nil
+	}
+}
+
+// EmitBatch Send the elements of a slice or an array to the result channel as one ResultBatch, each element is still a result of
+// the result handler, it saves a lot of channel operations when the API returns a page of items. An empty slice sends nothing,
+// if the results is not a slice or an array, it is sent as one result
+func (x *DataSourcePullTask) EmitBatch(ctx context.Context, resultChannel chan<- any, results any) error {
+	if results == nil {
+		return nil
+	}
+	if batch, ok := results.(ResultBatch); ok {
+		if len(batch) == 0 {
+			return nil
+		}
+		return x.Emit(ctx, resultChannel, batch)
+	}
+
+	value := reflect.ValueOf(results)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return x.Emit(ctx, resultChannel, results)
+	}
+	if value.Len() == 0 {
+		return nil
+	}
+	batch := make(ResultBatch, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		batch[i] = value.Index(i).Interface()
+	}
+	return x.Emit(ctx, resultChannel, batch)
+}
+
+func (x *DataSourcePullTask) addResultChannelBlockedTime(blockedTime time.Duration) {
+	if x.statistics == nil {
+		return
+	}
+	x.statistics.update(x, func(counters *PullCounters) {
+		counters.ResultChannelBlockedTime += blockedTime
+	})
+}
+
+// Sent through the result channel after some results, the cursor is saved when the executor reaches it
+type checkpointCursorMarker struct {
+	cursor string
+}
+
+// EmitCheckpointCursor Send the checkpoint cursor of the task through the result channel behind the results sent before it,
+// the cursor is saved by the executor after all of them are handled, so the results buffered in the channel are not skipped
+// when the pull is resumed. Use it instead of SetCheckpointCursor in the Pull
+func (x *DataSourcePullTask) EmitCheckpointCursor(ctx context.Context, resultChannel chan<- any, cursor string) error {
+	if x.checkpoint == nil || x.checkpointKey == "" {
+		return nil
+	}
+	return x.Emit(ctx, resultChannel, &checkpointCursorMarker{cursor: cursor})
+}
+
+// The results in a message of the result channel, a ResultBatch is taken apart
+func unpackResults(message any) []any {
+	if batch, ok := message.(ResultBatch); ok {
+		return batch
+	}
+	return []any{message}
+}
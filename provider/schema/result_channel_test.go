@@ -0,0 +1,173 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePullTask_EmitBatch(t *testing.T) {
+
+	executor := newTestExecutor(t, 1)
+
+	table := &Table{
+		TableName: "test_emit_batch_table",
+		Options: &TableOptions{
+			ResultChannelBufferSize: 1,
+		},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				if err := task.EmitBatch(ctx, resultChannel, []int{1, 2, 3}); err != nil {
+					return NewDiagnostics().AddError(err)
+				}
+				// empty batch sends nothing
+				if err := task.EmitBatch(ctx, resultChannel, []int{}); err != nil {
+					return NewDiagnostics().AddError(err)
+				}
+				for i := 4; i <= 30; i++ {
+					if err := task.Emit(ctx, resultChannel, i); err != nil {
+						return NewDiagnostics().AddError(err)
+					}
+				}
+				return nil
+			},
+		},
+	}
+
+	results := make([]any, 0)
+	resultLock := sync.Mutex{}
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  table,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			// a slow result handler, the Pull is blocked on the channel
+			time.Sleep(time.Millisecond * 2)
+			resultLock.Lock()
+			results = append(results, result)
+			resultLock.Unlock()
+			rows := NewRows("value")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: diagnosticsChannel,
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+	close(diagnosticsChannel)
+
+	for d := range diagnosticsChannel {
+		if d != nil {
+			assert.False(t, d.HasError(), d.ToString())
+		}
+	}
+
+	// each element of the batch is a result
+	assert.Len(t, results, 30)
+	assert.Equal(t, []any{1, 2, 3}, results[:3])
+
+	statistics := executor.Statistics().TableSnapshot(table.TableName)
+	assert.Equal(t, int64(30), statistics.RawResultCount)
+	assert.Equal(t, int64(30), statistics.RowCount)
+	assert.True(t, statistics.ResultChannelBlockedTime > 0)
+}
+
+func TestDataSourcePullTask_EmitCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	task := &DataSourcePullTask{Table: &Table{TableName: "test_emit_cancelled_table"}}
+	// the channel is full, the context is done
+	resultChannel := make(chan any)
+	assert.ErrorIs(t, task.Emit(ctx, resultChannel, 1), context.Canceled)
+	assert.ErrorIs(t, task.EmitBatch(ctx, resultChannel, []string{"a"}), context.Canceled)
+	assert.Nil(t, task.EmitBatch(ctx, resultChannel, nil))
+}
+
+func TestAdaptiveResultChannelBufferSize(t *testing.T) {
+	statistics := NewPullStatistics()
+	table := &Table{TableName: "test_adaptive_buffer_table"}
+	rootTask := &DataSourcePullTask{Table: table, IsRootTask: true}
+	childTask := &DataSourcePullTask{Table: table}
+
+	// no task is done
+	assert.Equal(t, InitialRootResultChannelBufferSize, adaptiveResultChannelBufferSize(rootTask, statistics))
+	assert.Equal(t, InitialChildResultChannelBufferSize, adaptiveResultChannelBufferSize(childTask, statistics))
+
+	// 2 tasks done with 3 results each
+	for i := 0; i < 2; i++ {
+		statistics.update(childTask, func(counters *PullCounters) {
+			counters.RawResultCount += 3
+		})
+		statistics.taskDone(childTask, time.Now(), time.Now())
+	}
+	assert.Equal(t, MinResultChannelBufferSize, adaptiveResultChannelBufferSize(childTask, statistics))
+
+	statistics.update(childTask, func(counters *PullCounters) {
+		counters.RawResultCount += 1000000
+	})
+	assert.Equal(t, DefaultResultChannelBufferSize, adaptiveResultChannelBufferSize(rootTask, statistics))
+
+	// set by the table
+	table.Options = &TableOptions{ResultChannelBufferSize: 50}
+	assert.Equal(t, 50, adaptiveResultChannelBufferSize(childTask, statistics))
+}
+
+func TestDataSourcePullTask_EmitWithRetry(t *testing.T) {
+	// the plain send, the blocked time can only be counted by the forwarder of the attempt
+	testEmitWithRetry(t, func(ctx context.Context, task *DataSourcePullTask, resultChannel chan<- any, result any) {
+		resultChannel <- result
+	})
+	// the Emit to the channel of the attempt, the blocked time is not counted twice
+	testEmitWithRetry(t, func(ctx context.Context, task *DataSourcePullTask, resultChannel chan<- any, result any) {
+		_ = task.Emit(ctx, resultChannel, result)
+	})
+}
+
+func testEmitWithRetry(t *testing.T, send func(ctx context.Context, task *DataSourcePullTask, resultChannel chan<- any, result any)) {
+
+	executor := newTestExecutor(t, 1)
+
+	table := &Table{
+		TableName: "test_emit_with_retry_table",
+		Options: &TableOptions{
+			ResultChannelBufferSize: 1,
+			RetryPolicy:             &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond * 10},
+		},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				for i := 1; i <= 30; i++ {
+					send(ctx, task, resultChannel, i)
+				}
+				return nil
+			},
+		},
+	}
+
+	begin := time.Now()
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  table,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			// a slow result handler, the results pile up in the result channel behind the forwarder of the attempt
+			time.Sleep(time.Millisecond * 2)
+			rows := NewRows("value")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: make(chan *Diagnostics, 100),
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+	cost := time.Since(begin)
+
+	statistics := executor.Statistics().TableSnapshot(table.TableName)
+	assert.Equal(t, int64(30), statistics.RowCount)
+	assert.True(t, statistics.ResultChannelBlockedTime > 0)
+	assert.True(t, statistics.ResultChannelBlockedTime < cost, "blocked %s, cost %s", statistics.ResultChannelBlockedTime, cost)
+}
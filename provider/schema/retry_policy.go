@@ -0,0 +1,158 @@
+package schema
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ------------------------------------------------- error class -------------------------------------------------------
+
+// ErrorClass Classify the error, to decide whether the failed operation should be retried
+type ErrorClass int
+
+const (
+
+	// ErrorClassPermanent Retry will not help, this is the default class of an error
+	ErrorClassPermanent ErrorClass = iota
+
+	// ErrorClassRetryable The error is transient, such as a network error or a 5xx response
+	ErrorClassRetryable
+
+	// ErrorClassThrottled The request is throttled by the API, such as a 429 response, it is retried with a longer backoff
+	ErrorClassThrottled
+
+	// ErrorClassAuth The credentials of the client are invalid or expired, such as a 401 or 403 response, it is never retried,
+	// and the client is not used any more after repeated auth failures, see ErrorsHandlerMeta.ClientAuthFailureThreshold
+	ErrorClassAuth
+)
+
+func (x ErrorClass) String() string {
+	switch x {
+	case ErrorClassPermanent:
+		return "permanent"
+	case ErrorClassRetryable:
+		return "retryable"
+	case ErrorClassThrottled:
+		return "throttled"
+	case ErrorClassAuth:
+		return "auth"
+	default:
+		return "unknown"
+	}
+}
+
+type classifiedError struct {
+	err        error
+	errorClass ErrorClass
+}
+
+func (x *classifiedError) Error() string {
+	return x.err.Error()
+}
+
+func (x *classifiedError) Unwrap() error {
+	return x.err
+}
+
+// NewRetryableError Mark the error as retryable, Diagnostics.AddError keep the class
+func NewRetryableError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{err: err, errorClass: ErrorClassRetryable}
+}
+
+// NewThrottledError Mark the error as throttled, Diagnostics.AddError keep the class
+func NewThrottledError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{err: err, errorClass: ErrorClassThrottled}
+}
+
+// NewAuthError Mark the error as an auth failure of the client, Diagnostics.AddError keep the class
+func NewAuthError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{err: err, errorClass: ErrorClassAuth}
+}
+
+// GetErrorClass Get the class of the error, the error not marked is permanent
+func GetErrorClass(err error) ErrorClass {
+	var e *classifiedError
+	if errors.As(err, &e) {
+		return e.errorClass
+	}
+	return ErrorClassPermanent
+}
+
+// ------------------------------------------------- retry policy ------------------------------------------------------
+
+// RetryPolicy When the DataSource.Pull of a table return retryable error diagnostics, how to retry it,
+// the panic of Pull and the permanent errors are never retried
+type RetryPolicy struct {
+
+	// How many times to pull at most, include the first one, less than or equal to 1 means no retry
+	MaxAttempts int
+
+	// The wait time before the first retry, if not set, use DefaultRetryInitialBackoff
+	InitialBackoff time.Duration
+
+	// The wait time never exceeds this, if not set, use DefaultRetryMaxBackoff
+	MaxBackoff time.Duration
+
+	// The wait time grows by this factor for each retry, if less than 1, use DefaultRetryMultiplier
+	Multiplier float64
+
+	// A random ratio in [0, 1] of the wait time is cut off, so that the clients do not retry at the same time
+	Jitter float64
+
+	// No more retry after this time since the first attempt begin, 0 means no limit
+	MaxElapsed time.Duration
+}
+
+const (
+	DefaultRetryInitialBackoff = time.Second
+	DefaultRetryMaxBackoff     = time.Second * 30
+	DefaultRetryMultiplier     = 2.0
+)
+
+// The throttled error wait longer than the normal retryable error
+const throttledBackoffFactor = 2
+
+// Backoff How long to wait before the given retry, retry begin from 1
+func (x *RetryPolicy) Backoff(retry int, isThrottled bool) time.Duration {
+	initialBackoff := x.InitialBackoff
+	if initialBackoff <= 0 {
+		initialBackoff = DefaultRetryInitialBackoff
+	}
+	maxBackoff := x.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultRetryMaxBackoff
+	}
+	multiplier := x.Multiplier
+	if multiplier < 1 {
+		multiplier = DefaultRetryMultiplier
+	}
+
+	backoff := float64(initialBackoff) * math.Pow(multiplier, float64(retry-1))
+	if isThrottled {
+		backoff *= throttledBackoffFactor
+	}
+	backoff = math.Min(backoff, float64(maxBackoff))
+	if x.Jitter > 0 {
+		backoff -= backoff * math.Min(x.Jitter, 1) * rand.Float64()
+	}
+	return time.Duration(backoff)
+}
+
+// IsRetryAllowed Whether the next attempt is allowed, attempt begin from 1
+func (x *RetryPolicy) IsRetryAllowed(nextAttempt int, elapsed time.Duration) bool {
+	if nextAttempt > x.MaxAttempts {
+		return false
+	}
+	return x.MaxElapsed <= 0 || elapsed < x.MaxElapsed
+}
@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Second * 5,
+		Multiplier:     2,
+	}
+	assert.Equal(t, time.Second, policy.Backoff(1, false))
+	assert.Equal(t, time.Second*4, policy.Backoff(3, false))
+	assert.Equal(t, time.Second*5, policy.Backoff(4, false))
+	assert.Equal(t, time.Second*2, policy.Backoff(1, true))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		backoff := policy.Backoff(2, false)
+		assert.GreaterOrEqual(t, backoff, time.Second)
+		assert.LessOrEqual(t, backoff, time.Second*2)
+	}
+
+	assert.True(t, policy.IsRetryAllowed(5, time.Hour))
+	assert.False(t, policy.IsRetryAllowed(6, 0))
+	policy.MaxElapsed = time.Minute
+	assert.False(t, policy.IsRetryAllowed(2, time.Minute))
+}
+
+func TestDiagnostics_IsRetryable(t *testing.T) {
+	assert.False(t, NewDiagnostics().IsRetryable())
+	assert.False(t, NewDiagnostics().AddErrorMsg("permanent").IsRetryable())
+	assert.True(t, NewDiagnostics().AddInfo("info").AddRetryableErrorMsg("timeout").IsRetryable())
+	assert.False(t, NewDiagnostics().AddRetryableErrorMsg("timeout").AddErrorMsg("permanent").IsRetryable())
+
+	throttled := NewDiagnostics().AddError(fmt.Errorf("list buckets: %w", NewThrottledError(errors.New("429 too many requests"))))
+	assert.True(t, throttled.IsRetryable())
+	assert.True(t, throttled.IsThrottled())
+	assert.Equal(t, ErrorClassRetryable, GetErrorClass(NewRetryableError(errors.New("connection reset"))))
+	assert.Equal(t, ErrorClassPermanent, GetErrorClass(errors.New("access denied")))
+}
@@ -2,8 +2,10 @@ package schema
 
 import (
 	"encoding/json"
+	"github.com/selefra/selefra-utils/pkg/md5_util"
 	"github.com/selefra/selefra-utils/pkg/reflect_util"
 	"github.com/spf13/cast"
+	"strings"
 )
 
 // Row Represents a row in a matrix or database
@@ -206,6 +208,19 @@ func (x *Row) ToRowsE() (*Rows, error) {
 	return rows, nil
 }
 
+// PrimaryKeysID The md5 of the values of the primary key columns, the rows with the same primary keys have the same id
+func (x *Row) PrimaryKeysID(primaryKeys []string) (string, error) {
+	columnValues := make([]string, 0, len(primaryKeys))
+	for _, columnName := range primaryKeys {
+		value, err := x.GetString(columnName)
+		if err != nil {
+			return "", err
+		}
+		columnValues = append(columnValues, value)
+	}
+	return md5_util.Md5String(strings.Join(columnValues, " | "))
+}
+
 // ------------------------------------------------- ------------------------------------------------------------------------
 
 func (x *Row) String() string {
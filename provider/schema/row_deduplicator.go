@@ -0,0 +1,106 @@
+package schema
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// MaxDeduplicatedRowsPerTable How many primary keys of a table are remembered in a pull, the rows after that are not checked
+var MaxDeduplicatedRowsPerTable = 1000000
+
+// Remember a hash of the primary keys of the rows saved in a pull, for the tables with TableOptions.DeduplicateRows,
+// a later row with the same keys is dropped, the first saved one is kept because the storage does not update a row
+type rowDeduplicator struct {
+	lock sync.Mutex
+
+	clientMeta *ClientMeta
+
+	// <tableName, the hash set of the primary keys>
+	tableKeySetMap map[string]map[uint64]struct{}
+}
+
+func newRowDeduplicator(clientMeta *ClientMeta) *rowDeduplicator {
+	return &rowDeduplicator{
+		clientMeta:     clientMeta,
+		tableKeySetMap: make(map[string]map[uint64]struct{}),
+	}
+}
+
+// Whether a row with the same primary keys is saved before in the table
+func (x *rowDeduplicator) isDuplicate(table *Table, key uint64) bool {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	_, exists := x.tableKeySetMap[table.TableName][key]
+	return exists
+}
+
+// The row is saved, remember its primary keys
+func (x *rowDeduplicator) remember(table *Table, key uint64) {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	keySet, exists := x.tableKeySetMap[table.TableName]
+	if !exists {
+		keySet = make(map[uint64]struct{})
+		x.tableKeySetMap[table.TableName] = keySet
+	}
+	if len(keySet) >= MaxDeduplicatedRowsPerTable {
+		return
+	}
+	keySet[key] = struct{}{}
+	if len(keySet) == MaxDeduplicatedRowsPerTable && x.clientMeta != nil {
+		x.clientMeta.WarnF("table %s has %d rows remembered for deduplication, the later rows are not checked", table.TableName, MaxDeduplicatedRowsPerTable)
+	}
+}
+
+// The value of PrimaryKeysID, but only the first 8 bytes of the md5 are kept to save the memory
+func primaryKeysHash(table *Table, row *Row) (uint64, *Diagnostics) {
+	id, err := row.PrimaryKeysID(table.GetPrimaryKeys())
+	if err == nil && len(id) < 16 {
+		err = fmt.Errorf("primary keys id %s is too short", id)
+	}
+	if err != nil {
+		return 0, NewDiagnostics().AddErrorMsg("table %s deduplicate row error: %s", table.TableName, err.Error())
+	}
+	key, err := strconv.ParseUint(id[:16], 16, 64)
+	if err != nil {
+		return 0, NewDiagnostics().AddErrorMsg("table %s deduplicate row error: %s", table.TableName, err.Error())
+	}
+	return key, nil
+}
+
+// IsDuplicateRow Whether a row with the same primary keys is already saved in this pull, if so, the row should be dropped,
+// it is always false if TableOptions.DeduplicateRows of the table is not set. The duplicate rows are counted in the statistics.
+// The row is not remembered here, call RowSaved after it is saved, so a row failed to save does not drop the later ones
+func (x *DataSourcePullTask) IsDuplicateRow(row *Row) (bool, *Diagnostics) {
+	if x.rowDeduplicator == nil || !x.Table.IsDeduplicateRows() || row == nil {
+		return false, nil
+	}
+	key, d := primaryKeysHash(x.Table, row)
+	if d != nil {
+		return false, d
+	}
+	isDuplicate := x.rowDeduplicator.isDuplicate(x.Table, key)
+	if isDuplicate && x.statistics != nil {
+		x.statistics.update(x, func(counters *PullCounters) {
+			counters.DuplicateRowCount++
+		})
+	}
+	return isDuplicate, nil
+}
+
+// RowSaved The row is saved to the storage, the later rows with the same primary keys in this pull are duplicate,
+// nothing is done if TableOptions.DeduplicateRows of the table is not set
+func (x *DataSourcePullTask) RowSaved(row *Row) *Diagnostics {
+	if x.rowDeduplicator == nil || !x.Table.IsDeduplicateRows() || row == nil {
+		return nil
+	}
+	key, d := primaryKeysHash(x.Table, row)
+	if d != nil {
+		return d
+	}
+	x.rowDeduplicator.remember(x.Table, key)
+	return nil
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataSourcePullTask_IsDuplicateRow(t *testing.T) {
+	table := &Table{
+		TableName: "test_deduplicate_rows_table",
+		Options: &TableOptions{
+			PrimaryKeys:     []string{"id", "region"},
+			DeduplicateRows: true,
+		},
+	}
+	statistics := NewPullStatistics()
+	task := &DataSourcePullTask{Table: table, statistics: statistics, rowDeduplicator: newRowDeduplicator(nil)}
+	newRow := func(id, region, name string) *Row {
+		return NewRow("id", "region", "name").SetValuesIgnoreError([]any{id, region, name})
+	}
+
+	isDuplicate, d := task.IsDuplicateRow(newRow("1", "us-east-1", "a"))
+	assert.Nil(t, d)
+	assert.False(t, isDuplicate)
+	// the row failed to save is not remembered
+	isDuplicate, _ = task.IsDuplicateRow(newRow("1", "us-east-1", "a"))
+	assert.False(t, isDuplicate)
+	assert.Nil(t, task.RowSaved(newRow("1", "us-east-1", "a")))
+	// the other columns are not compared
+	isDuplicate, _ = task.IsDuplicateRow(newRow("1", "us-east-1", "b"))
+	assert.True(t, isDuplicate)
+	isDuplicate, _ = task.IsDuplicateRow(newRow("1", "us-west-1", "a"))
+	assert.False(t, isDuplicate)
+	assert.Nil(t, task.RowSaved(newRow("1", "us-west-1", "a")))
+
+	// the rows of the other client are also duplicate
+	otherClientTask := task.Clone()
+	otherClientTask.Client = "other-client"
+	isDuplicate, _ = otherClientTask.IsDuplicateRow(newRow("1", "us-west-1", "a"))
+	assert.True(t, isDuplicate)
+	assert.Equal(t, int64(2), statistics.TableSnapshot(table.TableName).DuplicateRowCount)
+
+	// the primary key column is missing
+	_, d = task.IsDuplicateRow(NewRow("name").SetValuesIgnoreError([]any{"a"}))
+	assert.True(t, d.HasError())
+	assert.True(t, task.RowSaved(NewRow("name").SetValuesIgnoreError([]any{"a"})).HasError())
+
+	// not enabled
+	table.Options.DeduplicateRows = false
+	isDuplicate, _ = task.IsDuplicateRow(newRow("1", "us-east-1", "a"))
+	assert.False(t, isDuplicate)
+}
+
+func TestRowDeduplicator_MaxRows(t *testing.T) {
+	defer func(max int) {
+		MaxDeduplicatedRowsPerTable = max
+	}(MaxDeduplicatedRowsPerTable)
+	MaxDeduplicatedRowsPerTable = 2
+
+	table := &Table{TableName: "test_deduplicate_max_rows_table", Options: &TableOptions{PrimaryKeys: []string{"id"}}}
+	deduplicator := newRowDeduplicator(nil)
+	key := func(id string) uint64 {
+		key, d := primaryKeysHash(table, NewRow("id").SetValuesIgnoreError([]any{id}))
+		assert.Nil(t, d)
+		return key
+	}
+	for _, id := range []string{"1", "2", "3"} {
+		assert.False(t, deduplicator.isDuplicate(table, key(id)))
+		deduplicator.remember(table, key(id))
+	}
+	// the remembered rows are still checked, the rows after the max are not
+	assert.True(t, deduplicator.isDuplicate(table, key("2")))
+	assert.False(t, deduplicator.isDuplicate(table, key("3")))
+}
+
+func TestRow_PrimaryKeysID(t *testing.T) {
+	row := NewRow("id", "region", "name").SetValuesIgnoreError([]any{"1", "us-east-1", "a"})
+	id, err := row.PrimaryKeysID([]string{"id", "region"})
+	assert.Nil(t, err)
+	// the md5 of "1 | us-east-1"
+	assert.Equal(t, "51e4ad4620aafdc1a8146f1126d1d3d0", id)
+
+	_, err = row.PrimaryKeysID([]string{"id", "not_exists"})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,215 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ------------------------------------------------- JsonSpanExporter --------------------------------------------------
+
+// JsonSpanExporter Write each span as a line of JSON, it is easy to grep or load into other tools
+type JsonSpanExporter struct {
+	lock    sync.Mutex
+	writer  io.Writer
+	encoder *json.Encoder
+	closer  io.Closer
+}
+
+var _ SpanExporter = &JsonSpanExporter{}
+
+func NewJsonSpanExporter(writer io.Writer) *JsonSpanExporter {
+	return &JsonSpanExporter{
+		writer:  writer,
+		encoder: json.NewEncoder(writer),
+	}
+}
+
+// NewJsonFileSpanExporter Append the spans to the file, the directory is created if not exists, the file is closed on Shutdown
+func NewJsonFileSpanExporter(path string) (*JsonSpanExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	exporter := NewJsonSpanExporter(file)
+	exporter.closer = file
+	return exporter, nil
+}
+
+func (x *JsonSpanExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	for _, span := range spans {
+		if err := x.encoder.Encode(span); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *JsonSpanExporter) Shutdown(ctx context.Context) error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if x.closer == nil {
+		return nil
+	}
+	err := x.closer.Close()
+	x.closer = nil
+	return err
+}
+
+// ------------------------------------------------- OtlpHttpSpanExporter ----------------------------------------------
+
+// DefaultOtlpHttpTracesEndpoint The traces endpoint of a local OpenTelemetry collector
+const DefaultOtlpHttpTracesEndpoint = "http://localhost:4318/v1/traces"
+
+// OtlpHttpSpanExporter Send the spans to an OpenTelemetry collector by OTLP/HTTP with the JSON encoding
+type OtlpHttpSpanExporter struct {
+	endpoint    string
+	serviceName string
+	headers     map[string]string
+	client      *http.Client
+}
+
+var _ SpanExporter = &OtlpHttpSpanExporter{}
+
+// NewOtlpHttpSpanExporter The endpoint is the full url of the traces api, empty means DefaultOtlpHttpTracesEndpoint,
+// the service name is reported as the resource attribute service.name, usually the provider name
+func NewOtlpHttpSpanExporter(endpoint, serviceName string) *OtlpHttpSpanExporter {
+	if endpoint == "" {
+		endpoint = DefaultOtlpHttpTracesEndpoint
+	}
+	return &OtlpHttpSpanExporter{
+		endpoint:    endpoint,
+		serviceName: serviceName,
+		headers:     make(map[string]string),
+		client:      &http.Client{Timeout: time.Second * 10},
+	}
+}
+
+// SetHeader Send the header with each request, for example the authorization of the collector
+func (x *OtlpHttpSpanExporter) SetHeader(key, value string) *OtlpHttpSpanExporter {
+	x.headers[key] = value
+	return x
+}
+
+func (x *OtlpHttpSpanExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	body, err := json.Marshal(x.buildRequest(spans))
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, x.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range x.headers {
+		request.Header.Set(key, value)
+	}
+	response, err := x.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("export spans to %s failed, status = %d, response = %s", x.endpoint, response.StatusCode, string(message))
+	}
+	return nil
+}
+
+func (x *OtlpHttpSpanExporter) Shutdown(ctx context.Context) error {
+	x.client.CloseIdleConnections()
+	return nil
+}
+
+// The ExportTraceServiceRequest of OTLP in the JSON encoding, the ids are hex, the times are nanoseconds in string
+func (x *OtlpHttpSpanExporter) buildRequest(spans []*Span) map[string]any {
+	otlpSpans := make([]map[string]any, 0, len(spans))
+	for _, span := range spans {
+		otlpSpan := map[string]any{
+			"traceId":           span.TraceId,
+			"spanId":            span.SpanId,
+			"name":              span.Name,
+			"kind":              1, // SPAN_KIND_INTERNAL
+			"startTimeUnixNano": strconv.FormatInt(span.BeginTime.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(span.EndTime.UnixNano(), 10),
+			"attributes":        toOtlpAttributes(span.Attributes),
+		}
+		if span.ParentSpanId != "" {
+			otlpSpan["parentSpanId"] = span.ParentSpanId
+		}
+		if span.IsError {
+			// STATUS_CODE_ERROR
+			otlpSpan["status"] = map[string]any{"code": 2, "message": span.StatusMessage}
+		}
+		otlpSpans = append(otlpSpans, otlpSpan)
+	}
+	return map[string]any{
+		"resourceSpans": []any{
+			map[string]any{
+				"resource": map[string]any{
+					"attributes": toOtlpAttributes(map[string]any{"service.name": x.serviceName}),
+				},
+				"scopeSpans": []any{
+					map[string]any{
+						"scope": map[string]any{"name": "selefra-provider-sdk"},
+						"spans": otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func toOtlpAttributes(attributes map[string]any) []map[string]any {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	otlpAttributes := make([]map[string]any, 0, len(attributes))
+	for _, key := range keys {
+		var value map[string]any
+		switch v := attributes[key].(type) {
+		case string:
+			value = map[string]any{"stringValue": v}
+		case bool:
+			value = map[string]any{"boolValue": v}
+		case int:
+			value = map[string]any{"intValue": strconv.FormatInt(int64(v), 10)}
+		case int64:
+			value = map[string]any{"intValue": strconv.FormatInt(v, 10)}
+		case uint64:
+			value = map[string]any{"intValue": strconv.FormatUint(v, 10)}
+		case float64:
+			value = map[string]any{"doubleValue": v}
+		default:
+			value = map[string]any{"stringValue": fmt.Sprintf("%v", v)}
+		}
+		otlpAttributes = append(otlpAttributes, map[string]any{"key": key, "value": value})
+	}
+	return otlpAttributes
+}
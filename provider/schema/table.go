@@ -23,6 +23,9 @@ type Table struct {
 	// The data source used to provide data for this table
 	DataSource DataSource
 
+	// The parameters that the host can pass to the Pull of this table for a targeted refresh, read them by DataSourcePullTask.GetParameters
+	Parameters []*TableParameter
+
 	// If your table needs to extend the default call method, you can implement this function
 	// The default is to use Task once per client
 	// But you can call the client multiple times by making multiple copies of the client
@@ -33,6 +36,9 @@ type Table struct {
 	// An increasing number. If the table structure has changed, the version number needs to be changed
 	Version uint64
 
+	// Some tags to select the tables to pull, for example security, network or expensive
+	Tags []string
+
 	// The runtime of the table
 	runtime TableRuntime
 }
@@ -44,6 +50,80 @@ func (x *Table) GetPrimaryKeys() []string {
 	return x.Options.PrimaryKeys
 }
 
+// GetMaxConcurrency The max number of tasks of this table running at the same time, 0 means no limit
+func (x *Table) GetMaxConcurrency() int {
+	if x == nil || x.Options == nil {
+		return 0
+	}
+	return x.Options.MaxConcurrency
+}
+
+// GetPriority The scheduling priority of this table, higher first
+func (x *Table) GetPriority() int {
+	if x == nil || x.Options == nil {
+		return 0
+	}
+	return x.Options.Priority
+}
+
+// GetRateLimit The rate limit applied to each pull of this table, nil means no limit
+func (x *Table) GetRateLimit() *RateLimit {
+	if x == nil || x.Options == nil {
+		return nil
+	}
+	return x.Options.RateLimit
+}
+
+// GetRetryPolicy The retry policy of the pull of this table, nil means no retry
+func (x *Table) GetRetryPolicy() *RetryPolicy {
+	if x == nil || x.Options == nil {
+		return nil
+	}
+	return x.Options.RetryPolicy
+}
+
+// GetIncrementalCursorColumn The cursor column of the incremental pull, empty means the table is not incremental
+func (x *Table) GetIncrementalCursorColumn() string {
+	if x == nil || x.Options == nil {
+		return ""
+	}
+	return x.Options.IncrementalCursorColumn
+}
+
+// HasTag Whether the table has the tag
+func (x *Table) HasTag(tag string) bool {
+	for _, t := range x.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDependsOnTables The tables which this root table must be pulled after
+func (x *Table) GetDependsOnTables() []string {
+	if x == nil || x.Options == nil {
+		return nil
+	}
+	return x.Options.DependsOnTables
+}
+
+// GetResultChannelBufferSize The buffer size of the result channel of this table, 0 means adaptive
+func (x *Table) GetResultChannelBufferSize() int {
+	if x == nil || x.Options == nil {
+		return 0
+	}
+	return x.Options.ResultChannelBufferSize
+}
+
+// IsDeduplicateRows Whether the duplicate rows of this table are dropped in a pull
+func (x *Table) IsDeduplicateRows() bool {
+	if x == nil || x.Options == nil {
+		return false
+	}
+	return x.Options.DeduplicateRows
+}
+
 func (x *Table) GetFullTableName() string {
 	if x.GetNamespace() != "" {
 		return x.GetNamespace() + "." + x.TableName
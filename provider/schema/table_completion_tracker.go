@@ -0,0 +1,80 @@
+package schema
+
+import "sync"
+
+// Know when a table is really done, that is all its tasks are done and no more will be submitted, a sub table is done only after
+// its parent table. A table with any task cut off is never done, and neither are its sub tables
+type tableCompletionTracker struct {
+	lock sync.Mutex
+
+	// <tableName, unfinished task count>
+	unfinishedTaskCountMap map[string]int
+
+	completedTableSet map[string]bool
+
+	cutOffTableSet map[string]bool
+}
+
+func newTableCompletionTracker() *tableCompletionTracker {
+	return &tableCompletionTracker{
+		unfinishedTaskCountMap: make(map[string]int),
+		completedTableSet:      make(map[string]bool),
+		cutOffTableSet:         make(map[string]bool),
+	}
+}
+
+// A task is submitted, it must be called before the parent task is done
+func (x *tableCompletionTracker) taskSubmitted(task *DataSourcePullTask) {
+	if task.Table == nil {
+		return
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.unfinishedTaskCountMap[task.Table.TableName]++
+}
+
+// A task is done or dropped, return the tables completed because of it, the parent table is always before its sub tables
+func (x *tableCompletionTracker) taskDone(task *DataSourcePullTask, isCutOff bool) []*Table {
+	if task.Table == nil {
+		return nil
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	tableName := task.Table.TableName
+	x.unfinishedTaskCountMap[tableName]--
+	if isCutOff {
+		x.cutOffTableSet[tableName] = true
+	}
+
+	// The root table has no parent, the sub table waits for its parent table
+	if task.ParentTable != nil && !x.completedTableSet[task.ParentTable.TableName] {
+		return nil
+	}
+	return x.complete(task.Table, nil)
+}
+
+// Whether the table is done without any task cut off
+func (x *tableCompletionTracker) isCompleted(tableName string) bool {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	return x.completedTableSet[tableName]
+}
+
+// Complete the table if all its tasks are done, and then its sub tables, must be called with lock held
+func (x *tableCompletionTracker) complete(table *Table, completedTables []*Table) []*Table {
+	if x.completedTableSet[table.TableName] || x.cutOffTableSet[table.TableName] || x.unfinishedTaskCountMap[table.TableName] > 0 {
+		return completedTables
+	}
+	delete(x.unfinishedTaskCountMap, table.TableName)
+	x.completedTableSet[table.TableName] = true
+	completedTables = append(completedTables, table)
+	for _, subTable := range table.SubTables {
+		completedTables = x.complete(subTable, completedTables)
+	}
+	return completedTables
+}
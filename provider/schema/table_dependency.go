@@ -0,0 +1,196 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// SortTablesByDependencies Sort the root tables so that each table is after the tables it depends on, the order of the tables without
+// dependencies is kept. The dependency on a sub table is the dependency on its root table, the dependencies on the tables not in the
+// given tables are ignored, return error if the tables depend on each other
+func SortTablesByDependencies(tables []*Table) ([]*Table, error) {
+
+	// <tableName, the root table of it>
+	rootTableMap := make(map[string]*Table)
+	for _, table := range tables {
+		markRootTable(rootTableMap, table, table)
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	stateMap := make(map[string]int)
+	sortedTables := make([]*Table, 0, len(tables))
+	// The path of the visiting tables, used to tell the cycle
+	path := make([]string, 0)
+
+	var visit func(table *Table) error
+	visit = func(table *Table) error {
+		switch stateMap[table.TableName] {
+		case visited:
+			return nil
+		case visiting:
+			cycle := append([]string{}, path...)
+			for index, tableName := range cycle {
+				if tableName == table.TableName {
+					cycle = cycle[index:]
+					break
+				}
+			}
+			cycle = append(cycle, table.TableName)
+			return fmt.Errorf("tables depend on each other: %s", strings.Join(cycle, " -> "))
+		}
+
+		stateMap[table.TableName] = visiting
+		path = append(path, table.TableName)
+		for _, dependsOnTableName := range table.GetDependsOnTables() {
+			dependsOnRootTable, exists := rootTableMap[dependsOnTableName]
+			if !exists {
+				continue
+			}
+			if err := visit(dependsOnRootTable); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		stateMap[table.TableName] = visited
+		sortedTables = append(sortedTables, table)
+		return nil
+	}
+
+	for _, table := range tables {
+		if err := visit(table); err != nil {
+			return nil, err
+		}
+	}
+	return sortedTables, nil
+}
+
+func markRootTable(rootTableMap map[string]*Table, rootTable, table *Table) {
+	rootTableMap[table.TableName] = rootTable
+	for _, subTable := range table.SubTables {
+		markRootTable(rootTableMap, rootTable, subTable)
+	}
+}
+
+// ------------------------------------------------- --------------------------------------------------------------------
+
+// Hold the root tasks until the tables they depend on are settled
+type tableDependencyScheduler struct {
+	lock sync.Mutex
+
+	// <tableName, the name of its root table>, only the submitted root tables
+	rootTableNameMap map[string]string
+
+	// <rootTableName, unfinished task count of the whole tree>
+	unfinishedTaskCountMap map[string]int
+
+	// The root tables whose tasks are all done, no matter success or not
+	settledRootTableSet map[string]bool
+
+	// The root tasks waiting for their dependencies
+	waitingTasks []*DataSourcePullTask
+}
+
+func newTableDependencyScheduler() *tableDependencyScheduler {
+	return &tableDependencyScheduler{
+		rootTableNameMap:       make(map[string]string),
+		unfinishedTaskCountMap: make(map[string]int),
+		settledRootTableSet:    make(map[string]bool),
+	}
+}
+
+// A task is submitted, return true if it is a root task that must wait for its dependencies, then it is held until released
+func (x *tableDependencyScheduler) taskSubmitted(task *DataSourcePullTask) bool {
+	rootTable := task.RootTable()
+	if rootTable == nil {
+		return false
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if task.IsRootTask && !task.IsExpandDone {
+		for _, tableName := range flatTableNames(rootTable) {
+			x.rootTableNameMap[tableName] = rootTable.TableName
+		}
+	}
+	x.unfinishedTaskCountMap[rootTable.TableName]++
+
+	if !task.IsRootTask || task.IsExpandDone || len(x.unsettledDependencies(rootTable)) == 0 {
+		return false
+	}
+	x.waitingTasks = append(x.waitingTasks, task)
+	return true
+}
+
+// A task is done, return the waiting root tasks whose dependencies are all settled now
+func (x *tableDependencyScheduler) taskDone(task *DataSourcePullTask) []*DataSourcePullTask {
+	rootTable := task.RootTable()
+	if rootTable == nil {
+		return nil
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.unfinishedTaskCountMap[rootTable.TableName]--
+	if x.unfinishedTaskCountMap[rootTable.TableName] > 0 {
+		return nil
+	}
+	delete(x.unfinishedTaskCountMap, rootTable.TableName)
+	x.settledRootTableSet[rootTable.TableName] = true
+
+	releasedTasks := make([]*DataSourcePullTask, 0)
+	waitingTasks := make([]*DataSourcePullTask, 0, len(x.waitingTasks))
+	for _, waitingTask := range x.waitingTasks {
+		if len(x.unsettledDependencies(waitingTask.Table)) == 0 {
+			releasedTasks = append(releasedTasks, waitingTask)
+		} else {
+			waitingTasks = append(waitingTasks, waitingTask)
+		}
+	}
+	x.waitingTasks = waitingTasks
+	return releasedTasks
+}
+
+// The tables which the root table depends on and are pulled together
+func (x *tableDependencyScheduler) pulledDependencies(rootTable *Table) []string {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	tableNames := make([]string, 0)
+	for _, dependsOnTableName := range rootTable.GetDependsOnTables() {
+		if dependsOnRootTableName, exists := x.rootTableNameMap[dependsOnTableName]; exists && dependsOnRootTableName != rootTable.TableName {
+			tableNames = append(tableNames, dependsOnTableName)
+		}
+	}
+	return tableNames
+}
+
+// The names of the submitted root tables which the root table depends on and are not settled, must be called with lock held
+func (x *tableDependencyScheduler) unsettledDependencies(rootTable *Table) []string {
+	unsettledRootTableNames := make([]string, 0)
+	for _, dependsOnTableName := range rootTable.GetDependsOnTables() {
+		dependsOnRootTableName, exists := x.rootTableNameMap[dependsOnTableName]
+		// not pulled together, the data is already in the storage
+		if !exists || dependsOnRootTableName == rootTable.TableName {
+			continue
+		}
+		if !x.settledRootTableSet[dependsOnRootTableName] {
+			unsettledRootTableNames = append(unsettledRootTableNames, dependsOnRootTableName)
+		}
+	}
+	return unsettledRootTableNames
+}
+
+func flatTableNames(table *Table) []string {
+	tableNames := []string{table.TableName}
+	for _, subTable := range table.SubTables {
+		tableNames = append(tableNames, flatTableNames(subTable)...)
+	}
+	return tableNames
+}
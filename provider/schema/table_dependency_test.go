@@ -0,0 +1,122 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortTablesByDependencies(t *testing.T) {
+	users := &Table{TableName: "test_users", SubTables: []*Table{{TableName: "test_user_keys"}}}
+	groups := &Table{TableName: "test_groups"}
+	policies := &Table{TableName: "test_policies", Options: &TableOptions{DependsOnTables: []string{"test_user_keys", "test_groups", "test_not_pulled"}}}
+	roles := &Table{TableName: "test_roles", Options: &TableOptions{DependsOnTables: []string{"test_policies"}}}
+
+	sortedTables, err := SortTablesByDependencies([]*Table{roles, policies, users, groups})
+	assert.Nil(t, err)
+	tableNames := make([]string, 0)
+	for _, table := range sortedTables {
+		tableNames = append(tableNames, table.TableName)
+	}
+	assert.Equal(t, []string{"test_users", "test_groups", "test_policies", "test_roles"}, tableNames)
+
+	// cycle
+	groups.Options = &TableOptions{DependsOnTables: []string{"test_roles"}}
+	_, err = SortTablesByDependencies([]*Table{roles, policies, users, groups})
+	assert.EqualError(t, err, "tables depend on each other: test_roles -> test_policies -> test_groups -> test_roles")
+
+	// depends on its own sub table
+	users.Options = &TableOptions{DependsOnTables: []string{"test_user_keys"}}
+	_, err = SortTablesByDependencies([]*Table{users})
+	assert.EqualError(t, err, "tables depend on each other: test_users -> test_users")
+}
+
+func TestDataSourceExecutor_TableDependencies(t *testing.T) {
+
+	executor := newTestExecutor(t, 4)
+
+	// <tableName, pull begin time / pull end time>
+	beginTimeMap := make(map[string]time.Time)
+	endTimeMap := make(map[string]time.Time)
+	timeLock := sync.Mutex{}
+	newTable := func(tableName string, dependsOnTables []string, subTables ...*Table) *Table {
+		return &Table{
+			TableName: tableName,
+			SubTables: subTables,
+			Options:   &TableOptions{DependsOnTables: dependsOnTables},
+			DataSource: DataSource{
+				Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+					timeLock.Lock()
+					beginTimeMap[tableName] = time.Now()
+					timeLock.Unlock()
+					time.Sleep(time.Millisecond * 20)
+					resultChannel <- tableName
+					timeLock.Lock()
+					endTimeMap[tableName] = time.Now()
+					timeLock.Unlock()
+					return nil
+				},
+			},
+		}
+	}
+	users := newTable("test_dependency_users", nil, newTable("test_dependency_user_keys", nil))
+	policies := newTable("test_dependency_policies", []string{"test_dependency_user_keys"})
+	buckets := newTable("test_dependency_buckets", nil)
+	// the audits depends on the failed policies, which is cut off
+	failedPolicies := newTable("test_dependency_failed_policies", nil)
+	audits := newTable("test_dependency_audits", []string{failedPolicies.TableName, policies.TableName})
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	doneTables := make(map[string]bool)
+	diagnosticsChannel := make(chan *Diagnostics, 100)
+	submit := func(ctx context.Context, table *Table) {
+		executor.Submit(ctx, &DataSourcePullTask{
+			TaskId: id_util.RandomId(),
+			Ctx:    ctx,
+			Table:  table,
+			ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+				rows := NewRows("name")
+				_ = rows.AppendRowValues([]any{result})
+				return rows, []any{result}, nil
+			},
+			TableDoneCallback: func(ctx context.Context, clientMeta *ClientMeta, task *DataSourcePullTask, table *Table) *Diagnostics {
+				timeLock.Lock()
+				defer timeLock.Unlock()
+				doneTables[table.TableName] = true
+				return nil
+			},
+			DiagnosticsChannel: diagnosticsChannel,
+			IsRootTask:         true,
+		})
+	}
+	submit(context.Background(), users)
+	submit(context.Background(), buckets)
+	submit(context.Background(), policies)
+	submit(cancelledCtx, failedPolicies)
+	submit(context.Background(), audits)
+	executor.ShutdownAndAwaitTermination(context.Background())
+	close(diagnosticsChannel)
+
+	// the policies begins after the users and its sub table are done, the buckets does not wait
+	assert.True(t, beginTimeMap[policies.TableName].After(endTimeMap["test_dependency_user_keys"]))
+	assert.True(t, beginTimeMap[buckets.TableName].Before(endTimeMap[users.TableName]))
+	assert.True(t, doneTables[policies.TableName])
+
+	// the audits is skipped
+	assert.NotContains(t, beginTimeMap, audits.TableName)
+	assert.False(t, doneTables[audits.TableName])
+	assert.Equal(t, int64(1), executor.Statistics().TableSnapshot(audits.TableName).SkippedTaskCount)
+	skippedMessageCount := 0
+	for d := range diagnosticsChannel {
+		if d != nil && d.HasError() {
+			assert.Contains(t, d.ToString(), "the tables it depends on are not done: test_dependency_failed_policies")
+			skippedMessageCount++
+		}
+	}
+	assert.Equal(t, 1, skippedMessageCount)
+}
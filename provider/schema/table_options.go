@@ -18,6 +18,38 @@ type TableOptions struct {
 	// Indexes: There are some indexes that can be defined in a table. Generally,
 	// compound indexes are defined in this place. If an index involves only one column, then it is OK to define on the column
 	Indexes []*TableIndex
+
+	// The max number of tasks of this table running at the same time, include the expanded client tasks,
+	// used to protect an API with a strict concurrency cap, 0 means no limit
+	MaxConcurrency int
+
+	// The root tables with higher priority are scheduled first, the sub tables follow the priority of their root table
+	Priority int
+
+	// Every DataSource.Pull of this table takes a token of this rate limit before start, if more requests are sent in one Pull,
+	// use DataSourcePullTask.WaitRateLimit to take a token for each request
+	RateLimit *RateLimit
+
+	// How to retry when the DataSource.Pull of this table return retryable errors, nil means no retry
+	RetryPolicy *RetryPolicy
+
+	// Pull the table incrementally by this column, for example the event time of an audit log, after a successful pull,
+	// the max value of this column is saved as the watermark of each client, read it by DataSourcePullTask.GetPreviousWatermark
+	// to only request the newer records. The column should be time, integer or string, empty means full pull every time
+	IncrementalCursorColumn string
+
+	// The root table is pulled after these tables are done in the same pull, for example it reads their data from the storage,
+	// they can be root tables or sub tables of other root tables, the tables not pulled together are not waited for.
+	// If any of them is not done, for example cancelled, this table is skipped. Only the root table can have dependencies
+	DependsOnTables []string
+
+	// The buffer size of the channel that each DataSource.Pull of this table sends results to, 0 means adapt to the results
+	// of the done tasks of the table, see DefaultResultChannelBufferSize. It still shrinks when the memory is tight
+	ResultChannelBufferSize int
+
+	// Drop the rows whose primary keys are already saved in the same pull, for example the global resources returned for every region,
+	// the first row is kept. The table must have primary keys
+	DeduplicateRows bool
 }
 
 // GenPrimaryKeysName Automatically generate the name of the primary key
@@ -0,0 +1,195 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TableParameterType The type of the value of a table parameter
+type TableParameterType int
+
+const (
+	TableParameterTypeString TableParameterType = iota
+	TableParameterTypeStringArray
+	TableParameterTypeInt
+	TableParameterTypeBool
+	// RFC3339 in string, for example the begin or end of a time window
+	TableParameterTypeTimestamp
+)
+
+func (x TableParameterType) String() string {
+	switch x {
+	case TableParameterTypeString:
+		return "string"
+	case TableParameterTypeStringArray:
+		return "string_array"
+	case TableParameterTypeInt:
+		return "int"
+	case TableParameterTypeBool:
+		return "bool"
+	case TableParameterTypeTimestamp:
+		return "timestamp"
+	default:
+		return "unknown"
+	}
+}
+
+// TableParameter Declare a parameter that the Pull of the table accepts, for example some regions or a time window to refresh,
+// the value from the host is checked and converted before the pull starts, the Pull reads it by DataSourcePullTask.GetParameters
+type TableParameter struct {
+
+	// The name of the parameter, unique in the table
+	Name string
+
+	// Tell the user what the parameter does
+	Description string
+
+	Type TableParameterType
+
+	// The pull of the table fails if a required parameter is not given, a table with required parameters can only be pulled with parameters
+	IsRequired bool
+}
+
+// TableParameterValues The checked values of the parameters of a table, <parameterName, value>, the value is
+// string, []string, int64, bool or time.Time by the type of the parameter
+type TableParameterValues map[string]any
+
+// Has Whether the parameter is given
+func (x TableParameterValues) Has(name string) bool {
+	_, exists := x[name]
+	return exists
+}
+
+// GetString The value of the string parameter, empty if not given
+func (x TableParameterValues) GetString(name string) string {
+	value, _ := x[name].(string)
+	return value
+}
+
+// GetStringArray The value of the string array parameter, nil if not given
+func (x TableParameterValues) GetStringArray(name string) []string {
+	value, _ := x[name].([]string)
+	return value
+}
+
+// GetInt The value of the int parameter, 0 if not given
+func (x TableParameterValues) GetInt(name string) int64 {
+	value, _ := x[name].(int64)
+	return value
+}
+
+// GetBool The value of the bool parameter, false if not given
+func (x TableParameterValues) GetBool(name string) bool {
+	value, _ := x[name].(bool)
+	return value
+}
+
+// GetTimestamp The value of the timestamp parameter, false if not given
+func (x TableParameterValues) GetTimestamp(name string) (time.Time, bool) {
+	value, ok := x[name].(time.Time)
+	return value, ok
+}
+
+// CheckParameters Check the parameters from the host by the declaration of the table, and convert them to the declared types,
+// the values may be decoded from JSON, so the numbers may be float64 and the arrays may be []any
+func (x *Table) CheckParameters(values map[string]any) (TableParameterValues, *Diagnostics) {
+
+	diagnostics := NewDiagnostics()
+
+	parameterMap := make(map[string]*TableParameter, len(x.Parameters))
+	for _, parameter := range x.Parameters {
+		parameterMap[parameter.Name] = parameter
+	}
+
+	// sorted so that the errors are in a stable order
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	checkedValues := make(TableParameterValues, len(values))
+	for _, name := range names {
+		parameter, exists := parameterMap[name]
+		if !exists {
+			diagnostics.AddErrorMsg("table %s does not accept parameter %s", x.TableName, name)
+			continue
+		}
+		value, err := parameter.convert(values[name])
+		if err != nil {
+			diagnostics.AddErrorMsg("table %s parameter %s must be %s: %s", x.TableName, name, parameter.Type.String(), err.Error())
+			continue
+		}
+		checkedValues[name] = value
+	}
+
+	for _, parameter := range x.Parameters {
+		if _, exists := values[parameter.Name]; parameter.IsRequired && !exists {
+			diagnostics.AddErrorMsg("table %s parameter %s is required", x.TableName, parameter.Name)
+		}
+	}
+
+	return checkedValues, diagnostics
+}
+
+func (x *TableParameter) convert(value any) (any, error) {
+	switch x.Type {
+	case TableParameterTypeString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case TableParameterTypeStringArray:
+		switch v := value.(type) {
+		case []string:
+			return v, nil
+		case string:
+			// a single value is an array of one
+			return []string{v}, nil
+		case []any:
+			array := make([]string, 0, len(v))
+			for _, item := range v {
+				s, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("item %v is not string", item)
+				}
+				array = append(array, s)
+			}
+			return array, nil
+		}
+	case TableParameterTypeInt:
+		switch v := value.(type) {
+		case int:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("%v is not integer", v)
+			}
+			return int64(v), nil
+		case string:
+			return strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+		}
+	case TableParameterTypeBool:
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			return strconv.ParseBool(strings.TrimSpace(v))
+		}
+	case TableParameterTypeTimestamp:
+		switch v := value.(type) {
+		case time.Time:
+			return v, nil
+		case string:
+			return time.Parse(time.RFC3339Nano, strings.TrimSpace(v))
+		}
+	default:
+		return nil, fmt.Errorf("unknown parameter type %d", x.Type)
+	}
+	return nil, fmt.Errorf("can not use %v (%T)", value, value)
+}
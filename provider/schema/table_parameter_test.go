@@ -0,0 +1,109 @@
+package schema
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTable_CheckParameters(t *testing.T) {
+	table := &Table{
+		TableName: "test_parameter_table",
+		Parameters: []*TableParameter{
+			{Name: "regions", Type: TableParameterTypeStringArray, IsRequired: true},
+			{Name: "instance_id", Type: TableParameterTypeString},
+			{Name: "limit", Type: TableParameterTypeInt},
+			{Name: "include_deleted", Type: TableParameterTypeBool},
+			{Name: "since", Type: TableParameterTypeTimestamp},
+		},
+	}
+
+	// the values decoded from JSON are converted to the declared types
+	values, d := table.CheckParameters(map[string]any{
+		"regions":         []any{"us-east-1", "us-west-2"},
+		"limit":           float64(10),
+		"include_deleted": "true",
+		"since":           "2023-01-02T03:04:05Z",
+	})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, []string{"us-east-1", "us-west-2"}, values.GetStringArray("regions"))
+	assert.Equal(t, int64(10), values.GetInt("limit"))
+	assert.True(t, values.GetBool("include_deleted"))
+	since, ok := values.GetTimestamp("since")
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC), since)
+	assert.False(t, values.Has("instance_id"))
+	assert.Equal(t, "", values.GetString("instance_id"))
+
+	// a single value is an array of one
+	values, d = table.CheckParameters(map[string]any{"regions": "us-east-1"})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, []string{"us-east-1"}, values.GetStringArray("regions"))
+
+	// unknown, wrong type and missing required
+	_, d = table.CheckParameters(map[string]any{"zone": "a", "limit": 1.5, "since": "yesterday"})
+	assert.Equal(t, 4, len(d.GetDiagnosticSlice()), d.ToString())
+	assert.Contains(t, d.ToString(), "does not accept parameter zone")
+	assert.Contains(t, d.ToString(), "parameter regions is required")
+}
+
+func TestDataSourcePullTask_GetParameters(t *testing.T) {
+
+	executor := newTestExecutor(t, 2)
+
+	parametersMap := make(map[string]TableParameterValues)
+	parametersLock := sync.Mutex{}
+	subTable := &Table{
+		TableName: "test_parameter_sub_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				parametersLock.Lock()
+				defer parametersLock.Unlock()
+				parametersMap[task.Table.TableName] = task.GetParameters()
+				assert.True(t, task.isParameterized())
+				return nil
+			},
+		},
+	}
+	rootTable := &Table{
+		TableName: "test_parameter_root_table",
+		SubTables: []*Table{subTable},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				parametersLock.Lock()
+				parametersMap[task.Table.TableName] = task.GetParameters()
+				parametersLock.Unlock()
+				for _, region := range task.GetParameters().GetStringArray("regions") {
+					resultChannel <- region
+				}
+				return nil
+			},
+		},
+	}
+
+	executor.SetTableParameters(map[string]TableParameterValues{
+		rootTable.TableName: {"regions": []string{"us-east-1"}},
+	})
+	executor.Submit(context.Background(), &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    context.Background(),
+		Table:  rootTable,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			rows := NewRows("region")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: make(chan *Diagnostics, 100),
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(context.Background())
+
+	assert.Equal(t, TableParameterValues{"regions": []string{"us-east-1"}}, parametersMap[rootTable.TableName])
+	// the sub table has no parameters of its own, but it is a part of the parameterized pull
+	assert.Nil(t, parametersMap[subTable.TableName])
+	assert.Contains(t, parametersMap, subTable.TableName)
+}
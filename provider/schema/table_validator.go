@@ -43,6 +43,22 @@ func (x *tableValidator) validate(ctx context.Context, clientMeta *ClientMeta, p
 
 	}
 
+	// table parameters
+	parameterNameSet := make(map[string]struct{}, 0)
+	for _, parameter := range myTable.Parameters {
+		if parameter.Name == "" {
+			diagnostics.AddErrorMsg(x.buildMsg("Parameters: parameter name must not be empty"))
+			continue
+		}
+		if _, exists := parameterNameSet[parameter.Name]; exists {
+			diagnostics.AddErrorMsg(x.buildMsg(fmt.Sprintf("Parameters: table %s has more than one parameter named %s", myTable.TableName, parameter.Name)))
+		}
+		parameterNameSet[parameter.Name] = struct{}{}
+		if parameter.Type.String() == "unknown" {
+			diagnostics.AddErrorMsg(x.buildMsg(fmt.Sprintf("Parameters: table %s parameter %s has unknown type", myTable.TableName, parameter.Name)))
+		}
+	}
+
 	if myTable.Options != nil {
 
 		if myTable.Options.PrimaryKeys != nil {
@@ -63,6 +79,22 @@ func (x *tableValidator) validate(ctx context.Context, clientMeta *ClientMeta, p
 			}
 		}
 
+		if myTable.Options.MaxConcurrency < 0 {
+			diagnostics.AddErrorMsg(x.buildMsg(fmt.Sprintf("MaxConcurrency: table %s max concurrency can not be negative", myTable.TableName)))
+		}
+
+		if myTable.Options.RateLimit != nil && myTable.Options.RateLimit.RequestsPerSecond <= 0 {
+			diagnostics.AddErrorMsg(x.buildMsg(fmt.Sprintf("RateLimit: table %s requests per second must be positive", myTable.TableName)))
+		}
+
+		if myTable.Options.IncrementalCursorColumn != "" && !myTable.runtime.ContainsColumnName(myTable.Options.IncrementalCursorColumn) {
+			diagnostics.AddErrorMsg(x.buildMsg(fmt.Sprintf("IncrementalCursorColumn: table %s does not contain column %s", myTable.TableName, myTable.Options.IncrementalCursorColumn)))
+		}
+
+		if myTable.Options.DeduplicateRows && len(myTable.Options.PrimaryKeys) == 0 {
+			diagnostics.AddErrorMsg(x.buildMsg(fmt.Sprintf("DeduplicateRows: table %s must have primary keys to deduplicate rows", myTable.TableName)))
+		}
+
 		// do not validate fk, because can not access provider in here
 		//if myTable.Options.ForeignKeys != nil {
 		//	// check foreign keys exists
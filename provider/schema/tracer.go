@@ -0,0 +1,320 @@
+package schema
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Span A timed operation in the trace of a pull, the spans follow the task tree and are carried by the context,
+// all the methods are safe to call on nil, so the tracing costs nothing when it is not enabled
+type Span struct {
+	tracer *Tracer
+
+	TraceId      string `json:"trace_id"`
+	SpanId       string `json:"span_id"`
+	ParentSpanId string `json:"parent_span_id,omitempty"`
+
+	Name      string    `json:"name"`
+	BeginTime time.Time `json:"begin_time"`
+	EndTime   time.Time `json:"end_time"`
+
+	// The value should be string, bool, int, int64, float64 or something can be formatted as string
+	Attributes map[string]any `json:"attributes,omitempty"`
+
+	IsError       bool   `json:"is_error"`
+	StatusMessage string `json:"status_message,omitempty"`
+
+	lock    sync.Mutex
+	isEnded bool
+}
+
+// SetAttribute Attach a key value to the span
+func (x *Span) SetAttribute(key string, value any) *Span {
+	if x == nil {
+		return x
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	if x.Attributes == nil {
+		x.Attributes = make(map[string]any)
+	}
+	x.Attributes[key] = value
+	return x
+}
+
+// SetError Mark the span as failed
+func (x *Span) SetError(message string) *Span {
+	if x == nil {
+		return x
+	}
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+
+	x.IsError = true
+	x.StatusMessage = message
+	return x
+}
+
+// SetDiagnostics Mark the span as failed if the diagnostics has error
+func (x *Span) SetDiagnostics(diagnostics *Diagnostics) *Span {
+	if x == nil || diagnostics == nil || !diagnostics.HasError() {
+		return x
+	}
+	return x.SetError(diagnostics.ToString())
+}
+
+// End Finish the span and hand it to the exporter, only the first call takes effect
+func (x *Span) End() {
+	if x == nil {
+		return
+	}
+
+	x.lock.Lock()
+	if x.isEnded {
+		x.lock.Unlock()
+		return
+	}
+	x.isEnded = true
+	x.EndTime = time.Now()
+	x.lock.Unlock()
+
+	x.tracer.spanEnded(x)
+}
+
+// Duration From begin to end, zero if the span is not ended
+func (x *Span) Duration() time.Duration {
+	if x == nil || x.EndTime.IsZero() {
+		return 0
+	}
+	return x.EndTime.Sub(x.BeginTime)
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+type spanContextKey struct{}
+
+// ContextWithSpan The span is the parent of the spans started from the returned context
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext The current span, nil if the context is not traced
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// StartSpan Start a child span of the span in the context, return the context carrying the new span,
+// if the context is not traced, do nothing and return the nil span
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		return ctx, nil
+	}
+	return parent.tracer.StartSpan(ctx, name)
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// SpanExporter Where the ended spans go, it may be shared by several tracers, so it must be safe for concurrent use
+type SpanExporter interface {
+
+	// ExportSpans Export a batch of ended spans, the spans must not be modified
+	ExportSpans(ctx context.Context, spans []*Span) error
+
+	// Shutdown Flush and release the resources, the exporter can not be used after shutdown
+	Shutdown(ctx context.Context) error
+}
+
+// DefaultTracerBatchSize How many ended spans are buffered before exported
+const DefaultTracerBatchSize = 512
+
+// DefaultTracerQueueSize How many batches can wait for the export, the batch is dropped when the queue is full,
+// so a slow collector never blocks the pull
+const DefaultTracerQueueSize = 16
+
+// Tracer Start the spans and export them in batch, the batches are exported in background one by one
+type Tracer struct {
+	exporter  SpanExporter
+	batchSize int
+
+	lock          sync.Mutex
+	bufferedSpans []*Span
+	// The first export error, returned by Flush
+	exportErr error
+
+	queue chan []*Span
+	// The batches in the queue or being exported, Flush waits for them, guarded by lock
+	pendingBatchCount int
+	pendingBatchDone  *sync.Cond
+	isWorkerRunning   atomic.Bool
+	droppedSpanCount  atomic.Int64
+}
+
+func NewTracer(exporter SpanExporter) *Tracer {
+	x := &Tracer{
+		exporter:      exporter,
+		batchSize:     DefaultTracerBatchSize,
+		bufferedSpans: make([]*Span, 0),
+		queue:         make(chan []*Span, DefaultTracerQueueSize),
+	}
+	x.pendingBatchDone = sync.NewCond(&x.lock)
+	return x
+}
+
+// SetBatchSize How many ended spans are buffered before exported, less than or equal to 1 means export each span as soon as it is ended
+func (x *Tracer) SetBatchSize(batchSize int) *Tracer {
+	x.batchSize = batchSize
+	return x
+}
+
+// SetQueueSize How many batches can wait for the export, must be called before any span is ended
+func (x *Tracer) SetQueueSize(queueSize int) *Tracer {
+	if queueSize < 1 {
+		queueSize = 1
+	}
+	x.queue = make(chan []*Span, queueSize)
+	return x
+}
+
+// StartSpan Start a span, it is the child of the span in the context if any, otherwise it begins a new trace,
+// a nil tracer does nothing and return the nil span
+func (x *Tracer) StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	if x == nil {
+		return ctx, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	span := &Span{
+		tracer:    x,
+		SpanId:    randomHexId(8),
+		Name:      name,
+		BeginTime: time.Now(),
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.TraceId = parent.TraceId
+		span.ParentSpanId = parent.SpanId
+	} else {
+		span.TraceId = randomHexId(16)
+	}
+	return ContextWithSpan(ctx, span), span
+}
+
+// Flush Wait for the queued batches and export all the buffered spans, return the first error occurred since the last flush,
+// or the error telling how many spans are dropped because the queue is full
+func (x *Tracer) Flush(ctx context.Context) error {
+	if x == nil {
+		return nil
+	}
+
+	x.lock.Lock()
+	for x.pendingBatchCount > 0 {
+		x.pendingBatchDone.Wait()
+	}
+	spans := x.bufferedSpans
+	x.bufferedSpans = make([]*Span, 0)
+	x.lock.Unlock()
+
+	x.export(ctx, spans)
+
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	err := x.exportErr
+	x.exportErr = nil
+	if droppedSpanCount := x.droppedSpanCount.Swap(0); err == nil && droppedSpanCount > 0 {
+		err = fmt.Errorf("%d spans are dropped, because the export queue is full", droppedSpanCount)
+	}
+	return err
+}
+
+func (x *Tracer) spanEnded(span *Span) {
+	if x == nil || x.exporter == nil {
+		return
+	}
+
+	x.lock.Lock()
+	x.bufferedSpans = append(x.bufferedSpans, span)
+	if len(x.bufferedSpans) < x.batchSize {
+		x.lock.Unlock()
+		return
+	}
+	spans := x.bufferedSpans
+	x.bufferedSpans = make([]*Span, 0)
+	isQueued := x.enqueue(spans)
+	x.lock.Unlock()
+
+	if !isQueued {
+		x.droppedSpanCount.Add(int64(len(spans)))
+		return
+	}
+	if x.isWorkerRunning.CompareAndSwap(false, true) {
+		go x.exportQueue()
+	}
+}
+
+// Hand the batch to the background worker, return false if the queue is full, must be called with lock held
+func (x *Tracer) enqueue(spans []*Span) bool {
+	select {
+	case x.queue <- spans:
+		x.pendingBatchCount++
+		return true
+	default:
+		return false
+	}
+}
+
+// The worker exports the queued batches one by one, and exits when the queue is empty
+func (x *Tracer) exportQueue() {
+	for {
+		select {
+		case spans := <-x.queue:
+			x.export(context.Background(), spans)
+			x.lock.Lock()
+			x.pendingBatchCount--
+			x.pendingBatchDone.Broadcast()
+			x.lock.Unlock()
+		default:
+			x.isWorkerRunning.Store(false)
+			// a batch may be queued after the queue is found empty and before the flag is cleared
+			if len(x.queue) == 0 || !x.isWorkerRunning.CompareAndSwap(false, true) {
+				return
+			}
+		}
+	}
+}
+
+func (x *Tracer) export(ctx context.Context, spans []*Span) {
+	if len(spans) == 0 || x.exporter == nil {
+		return
+	}
+	if err := x.exporter.ExportSpans(ctx, spans); err != nil {
+		x.lock.Lock()
+		defer x.lock.Unlock()
+		if x.exportErr == nil {
+			x.exportErr = err
+		}
+	}
+}
+
+// The trace id is 16 bytes, and the span id is 8 bytes, as the W3C trace context and OTLP
+func randomHexId(byteCount int) string {
+	bytes := make([]byte, byteCount)
+	_, _ = rand.Read(bytes)
+	return hex.EncodeToString(bytes)
+}
@@ -0,0 +1,239 @@
+package schema
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/selefra/selefra-utils/pkg/id_util"
+	"github.com/stretchr/testify/assert"
+)
+
+type testMemorySpanExporter struct {
+	lock  sync.Mutex
+	spans []*Span
+}
+
+func (x *testMemorySpanExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	x.lock.Lock()
+	defer x.lock.Unlock()
+	x.spans = append(x.spans, spans...)
+	return nil
+}
+
+func (x *testMemorySpanExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func TestTracer_TaskTree(t *testing.T) {
+
+	executor := newTestExecutor(t, 2)
+	executor.clientMeta.runtime.client = []any{"client-a", "client-b"}
+
+	subTable := &Table{
+		TableName: "test_trace_sub_table",
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				// the spans started by the data source are under the pull span
+				_, span := StartSpan(ctx, "list_items")
+				span.End()
+				return NewDiagnostics().AddErrorMsg("sub table error")
+			},
+		},
+	}
+	rootTable := &Table{
+		TableName: "test_trace_root_table",
+		SubTables: []*Table{subTable},
+		DataSource: DataSource{
+			Pull: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, resultChannel chan<- any) *Diagnostics {
+				resultChannel <- client
+				return nil
+			},
+		},
+	}
+
+	exporter := &testMemorySpanExporter{}
+	tracer := NewTracer(exporter).SetBatchSize(2)
+	ctx, pullSpan := tracer.StartSpan(context.Background(), "pull_tables")
+	executor.Submit(ctx, &DataSourcePullTask{
+		TaskId: id_util.RandomId(),
+		Ctx:    ctx,
+		Table:  rootTable,
+		ResultHandler: func(ctx context.Context, clientMeta *ClientMeta, client any, task *DataSourcePullTask, result any) (*Rows, []any, *Diagnostics) {
+			rows := NewRows("value")
+			_ = rows.AppendRowValues([]any{result})
+			return rows, []any{result}, nil
+		},
+		DiagnosticsChannel: make(chan *Diagnostics, 100),
+		IsRootTask:         true,
+	})
+	executor.ShutdownAndAwaitTermination(ctx)
+	pullSpan.End()
+	assert.Nil(t, tracer.Flush(context.Background()))
+
+	spanMap := make(map[string]*Span)
+	nameCount := make(map[string]int)
+	for _, span := range exporter.spans {
+		spanMap[span.SpanId] = span
+		nameCount[span.Name]++
+		assert.Equal(t, pullSpan.TraceId, span.TraceId)
+		assert.False(t, span.EndTime.IsZero())
+	}
+	// root task + 2 client tasks + 2 sub tasks
+	assert.Equal(t, map[string]int{"pull_tables": 1, "task": 5, "expand": 1, "pull": 4, "result_handler": 2, "list_items": 2}, nameCount)
+
+	parentOf := func(span *Span) *Span {
+		return spanMap[span.ParentSpanId]
+	}
+	for _, span := range exporter.spans {
+		switch span.Name {
+		case "task":
+			parent := parentOf(span)
+			switch span.Attributes["table_name"] {
+			case rootTable.TableName:
+				if span.Attributes["is_root_task"] == true && span.Attributes["client"] == nil {
+					// the task before expand
+					assert.Equal(t, pullSpan.SpanId, span.ParentSpanId)
+				} else {
+					// the client task is under the root task
+					assert.Equal(t, "task", parent.Name)
+					assert.Nil(t, parent.Attributes["client"])
+				}
+			case subTable.TableName:
+				// the child task is under the client task of the parent table
+				assert.Equal(t, rootTable.TableName, parent.Attributes["table_name"])
+				assert.Equal(t, span.Attributes["client"], parent.Attributes["client"])
+				assert.True(t, span.IsError)
+			}
+		case "expand", "pull", "result_handler":
+			assert.Equal(t, "task", parentOf(span).Name)
+		case "list_items":
+			assert.Equal(t, "pull", parentOf(span).Name)
+		}
+	}
+}
+
+func TestDataSourcePullTask_TraceId(t *testing.T) {
+	task := &DataSourcePullTask{TaskId: "task-1"}
+	assert.Equal(t, "", task.TraceId())
+	assert.Equal(t, "task-1", task.logId())
+
+	// not traced, the span is nil and everything is no-op
+	ctx, span := StartSpan(context.Background(), "nothing")
+	assert.Nil(t, span)
+	span.SetAttribute("key", "value").SetError("error").End()
+
+	ctx, span = NewTracer(nil).StartSpan(ctx, "traced")
+	task.Ctx = ctx
+	assert.Len(t, task.TraceId(), 32)
+	assert.Len(t, span.SpanId, 16)
+	assert.Equal(t, "task-1, traceId = "+span.TraceId+", spanId = "+span.SpanId, task.logId())
+}
+
+func TestJsonSpanExporter(t *testing.T) {
+	buff := &bytes.Buffer{}
+	tracer := NewTracer(NewJsonSpanExporter(buff))
+	_, span := tracer.StartSpan(context.Background(), "test_span")
+	span.SetAttribute("table_name", "test_table").SetDiagnostics(NewDiagnostics().AddErrorMsg("failed"))
+	span.End()
+	assert.Nil(t, tracer.Flush(context.Background()))
+
+	line := make(map[string]any)
+	assert.Nil(t, json.Unmarshal(buff.Bytes(), &line))
+	assert.Equal(t, "test_span", line["name"])
+	assert.Equal(t, span.TraceId, line["trace_id"])
+	assert.Equal(t, true, line["is_error"])
+	assert.Equal(t, map[string]any{"table_name": "test_table"}, line["attributes"])
+}
+
+func TestOtlpHttpSpanExporter(t *testing.T) {
+	var body map[string]any
+	var contentType, token string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		token = r.Header.Get("Authorization")
+		bs, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(bs, &body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	exporter := NewOtlpHttpSpanExporter(server.URL+"/v1/traces", "test-provider").SetHeader("Authorization", "Bearer token")
+	tracer := NewTracer(exporter)
+	ctx, parent := tracer.StartSpan(context.Background(), "parent")
+	_, child := StartSpan(ctx, "child")
+	child.SetAttribute("row_count", 3).SetError("failed").End()
+	parent.End()
+	assert.Nil(t, tracer.Flush(context.Background()))
+	assert.Nil(t, exporter.Shutdown(context.Background()))
+
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, "Bearer token", token)
+	resourceSpans := body["resourceSpans"].([]any)[0].(map[string]any)
+	assert.Equal(t, []any{map[string]any{"key": "service.name", "value": map[string]any{"stringValue": "test-provider"}}}, resourceSpans["resource"].(map[string]any)["attributes"])
+	spans := resourceSpans["scopeSpans"].([]any)[0].(map[string]any)["spans"].([]any)
+	assert.Len(t, spans, 2)
+	otlpChild := spans[0].(map[string]any)
+	assert.Equal(t, "child", otlpChild["name"])
+	assert.Equal(t, parent.SpanId, otlpChild["parentSpanId"])
+	assert.Equal(t, parent.TraceId, otlpChild["traceId"])
+	assert.Equal(t, map[string]any{"code": float64(2), "message": "failed"}, otlpChild["status"])
+	assert.Equal(t, []any{map[string]any{"key": "row_count", "value": map[string]any{"intValue": "3"}}}, otlpChild["attributes"])
+
+	// the collector is down
+	server.Close()
+	_, span := tracer.StartSpan(context.Background(), "lost")
+	span.End()
+	assert.NotNil(t, tracer.Flush(context.Background()))
+}
+
+// The exporter hangs until released
+type testBlockingSpanExporter struct {
+	testMemorySpanExporter
+	release chan struct{}
+}
+
+func (x *testBlockingSpanExporter) ExportSpans(ctx context.Context, spans []*Span) error {
+	<-x.release
+	return x.testMemorySpanExporter.ExportSpans(ctx, spans)
+}
+
+func TestTracer_SlowExporter(t *testing.T) {
+	exporter := &testBlockingSpanExporter{release: make(chan struct{})}
+	tracer := NewTracer(exporter).SetBatchSize(1).SetQueueSize(2)
+
+	// the spans end at once even if the exporter hangs, the spans more than the queue can hold are dropped
+	ended := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			_, span := tracer.StartSpan(context.Background(), "test_span")
+			span.End()
+		}
+		close(ended)
+	}()
+	select {
+	case <-ended:
+	case <-time.After(time.Second * 5):
+		t.Fatal("the span end is blocked by the exporter")
+	}
+
+	close(exporter.release)
+	err := tracer.Flush(context.Background())
+	assert.NotNil(t, err)
+	exportedCount := len(exporter.spans)
+	assert.True(t, exportedCount >= 2 && exportedCount <= 3)
+	assert.Equal(t, fmt.Sprintf("%d spans are dropped, because the export queue is full", 10-exportedCount), err.Error())
+
+	// the queue is empty again
+	_, span := tracer.StartSpan(context.Background(), "test_span")
+	span.End()
+	assert.Nil(t, tracer.Flush(context.Background()))
+	assert.Len(t, exporter.spans, exportedCount+1)
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+)
+
+// TableRegexPatternPrefix The table pattern with this prefix is a regular expression, for example "regex:^aws_(ec2|s3)_"
+const TableRegexPatternPrefix = "regex:"
+
+// tablePattern A pattern to select the tables, it is an exact table name, a glob pattern or a regular expression
+type tablePattern struct {
+	raw string
+
+	glob  string
+	regex *regexp.Regexp
+}
+
+func compileTablePatterns(patterns []string) ([]*tablePattern, *schema.Diagnostics) {
+	diagnostics := schema.NewDiagnostics()
+	tablePatterns := make([]*tablePattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		tablePattern, err := compileTablePattern(pattern)
+		if err != nil {
+			diagnostics.AddErrorMsg("table pattern %s is invalid: %s", pattern, err.Error())
+			continue
+		}
+		tablePatterns = append(tablePatterns, tablePattern)
+	}
+	return tablePatterns, diagnostics
+}
+
+func compileTablePattern(pattern string) (*tablePattern, error) {
+	if strings.HasPrefix(pattern, TableRegexPatternPrefix) {
+		regex, err := regexp.Compile(strings.TrimPrefix(pattern, TableRegexPatternPrefix))
+		if err != nil {
+			return nil, err
+		}
+		return &tablePattern{raw: pattern, regex: regex}, nil
+	}
+	// check the syntax of the glob pattern once, path.Match only reports it when it is matched
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+	return &tablePattern{raw: pattern, glob: pattern}, nil
+}
+
+// Whether the pattern is a table name, not a glob or regular expression
+func (x *tablePattern) isExact() bool {
+	return x.regex == nil && !strings.ContainsAny(x.glob, `*?[\`)
+}
+
+func (x *tablePattern) match(tableName string) bool {
+	if x.regex != nil {
+		return x.regex.MatchString(tableName)
+	}
+	matched, _ := path.Match(x.glob, tableName)
+	return matched
+}
+
+// Only keep the sub tables in the set, the table is copied if any of its sub tables is removed, so the table of the provider is not changed
+func pruneTable(table *schema.Table, keepTableSet map[string]bool) *schema.Table {
+	subTables := make([]*schema.Table, 0, len(table.SubTables))
+	isPruned := false
+	for _, subTable := range table.SubTables {
+		if !keepTableSet[subTable.TableName] {
+			isPruned = true
+			continue
+		}
+		prunedSubTable := pruneTable(subTable, keepTableSet)
+		if prunedSubTable != subTable {
+			isPruned = true
+		}
+		subTables = append(subTables, prunedSubTable)
+	}
+	if !isPruned {
+		return table
+	}
+	prunedTable := *table
+	prunedTable.SubTables = subTables
+	return &prunedTable
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/selefra/selefra-provider-sdk/grpc/shard"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-provider-sdk/provider/transformer/column_value_extractor"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderRuntime_computeNeedPullRootTables(t *testing.T) {
+
+	newTable := func(tableName string, tags []string, subTables ...*schema.Table) *schema.Table {
+		return &schema.Table{
+			TableName: tableName,
+			Tags:      tags,
+			Columns: []*schema.Column{
+				{
+					ColumnName: "name",
+					Type:       schema.ColumnTypeString,
+					Extractor:  column_value_extractor.StructSelector("Name"),
+				},
+			},
+			SubTables: subTables,
+		}
+	}
+	provider := &Provider{
+		Name:    "test-provider",
+		Version: "v0.1",
+		TableList: []*schema.Table{
+			newTable("aws_ec2_instances", []string{"compute"},
+				newTable("aws_ec2_instance_volumes", []string{"storage", "expensive"}),
+				newTable("aws_ec2_instance_security_groups", []string{"security", "network"}),
+			),
+			newTable("aws_s3_buckets", []string{"storage"},
+				newTable("aws_s3_bucket_policies", []string{"security"}),
+			),
+			newTable("aws_iam_users", []string{"security"}),
+		},
+	}
+	runtime, d := NewProviderRuntime(context.Background(), provider)
+	assert.False(t, d.HasError(), d.ToString())
+
+	// <rootTableName, the tables of the pruned tree>
+	selectTables := func(request *shard.PullTablesRequest) (map[string][]string, *schema.Diagnostics) {
+		pullTables, d := runtime.computeNeedPullRootTables(request)
+		selected := make(map[string][]string)
+		for _, table := range pullTables {
+			selected[table.TableName] = runtime.flatTable(table)
+		}
+		return selected, d
+	}
+
+	selected, d := selectTables(&shard.PullTablesRequest{Tables: []string{"*"}})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Len(t, selected, 3)
+	assert.Len(t, selected["aws_ec2_instances"], 3)
+
+	// glob with exclude, the sub tables of the excluded table are excluded too
+	selected, d = selectTables(&shard.PullTablesRequest{Tables: []string{"aws_*"}, ExcludeTables: []string{"aws_s3_*", "aws_ec2_instance_volumes"}})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, map[string][]string{
+		"aws_ec2_instances": {"aws_ec2_instances", "aws_ec2_instance_security_groups"},
+		"aws_iam_users":     {"aws_iam_users"},
+	}, selected)
+	// the table of the provider is not changed
+	assert.Len(t, provider.TableList[0].SubTables, 2)
+
+	// the sub table is pulled with its ancestors only
+	selected, d = selectTables(&shard.PullTablesRequest{Tables: []string{"regex:_policies$"}})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, map[string][]string{"aws_s3_buckets": {"aws_s3_buckets", "aws_s3_bucket_policies"}}, selected)
+
+	// by tags
+	selected, d = selectTables(&shard.PullTablesRequest{Tags: []string{"security"}, ExcludeTags: []string{"network"}})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, map[string][]string{
+		"aws_s3_buckets": {"aws_s3_buckets", "aws_s3_bucket_policies"},
+		"aws_iam_users":  {"aws_iam_users"},
+	}, selected)
+	selected, d = selectTables(&shard.PullTablesRequest{Tables: []string{"*"}, ExcludeTags: []string{"expensive"}})
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, []string{"aws_ec2_instances", "aws_ec2_instance_security_groups"}, selected["aws_ec2_instances"])
+
+	// the patterns matching nothing
+	_, d = selectTables(&shard.PullTablesRequest{Tables: []string{"aws_not_exists", "gcp_*"}, Tags: []string{"unknown"}})
+	assert.Len(t, d.GetDiagnosticSlice(), 3)
+	assert.Contains(t, d.ToString(), "table aws_not_exists not exists")
+	assert.Contains(t, d.ToString(), "no table matches gcp_*")
+	assert.Contains(t, d.ToString(), "no table has tag unknown")
+	_, d = selectTables(&shard.PullTablesRequest{Tables: []string{"regex:("}})
+	assert.True(t, d.HasError())
+	_, d = selectTables(&shard.PullTablesRequest{Tables: []string{"aws_iam_users"}, ExcludeTables: []string{"aws_iam_*"}})
+	assert.Contains(t, d.ToString(), "all the selected tables are excluded")
+	selected, d = selectTables(&shard.PullTablesRequest{Tables: []string{"aws_iam_users"}, ExcludeTables: []string{"gcp_*"}})
+	assert.False(t, d.HasError())
+	assert.Len(t, d.GetDiagnosticSlice(), 1)
+	assert.Len(t, selected, 1)
+}
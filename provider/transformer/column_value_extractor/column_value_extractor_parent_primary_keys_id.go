@@ -3,8 +3,6 @@ package column_value_extractor
 import (
 	"context"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
-	"github.com/selefra/selefra-utils/pkg/md5_util"
-	"strings"
 )
 
 type ColumnValueExtractorParentPrimaryKeysID struct {
@@ -33,20 +31,7 @@ func (x *ColumnValueExtractorParentPrimaryKeysID) Extract(ctx context.Context, c
 		return nil, diagnostics.AddErrorMsg(BuildExtractErrMsg(x, task.Table, column, "parent table not have primary key"))
 	}
 
-	columnValues := make([]string, 0)
-	for _, parentColumnName := range task.ParentTable.GetPrimaryKeys() {
-		value, err := task.ParentRow.GetString(parentColumnName)
-		if err != nil {
-			diagnostics.AddErrorMsg(BuildExtractErr(x, task.Table, column, err))
-		} else {
-			columnValues = append(columnValues, value)
-		}
-	}
-	if diagnostics.HasError() {
-		return nil, diagnostics
-	}
-
-	value, err := md5_util.Md5String(strings.Join(columnValues, " | "))
+	value, err := task.ParentRow.PrimaryKeysID(task.ParentTable.GetPrimaryKeys())
 	if err != nil {
 		return nil, diagnostics.AddErrorMsg(BuildExtractErr(x, task.Table, column, err))
 	}
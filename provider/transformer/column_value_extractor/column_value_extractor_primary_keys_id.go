@@ -4,8 +4,6 @@ import (
 	"context"
 	"errors"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
-	"github.com/selefra/selefra-utils/pkg/md5_util"
-	"strings"
 )
 
 type ColumnValueExtractorPrimaryKeysID struct {
@@ -26,16 +24,7 @@ func (x *ColumnValueExtractorPrimaryKeysID) Extract(ctx context.Context, clientM
 		return nil, diagnostics.AddErrorMsg(BuildExtractErrMsg(x, table, column, "table not have primary keys"))
 	}
 
-	columnValues := make([]string, 0)
-	for _, columnName := range table.GetPrimaryKeys() {
-		value, err := row.GetString(columnName)
-		if err != nil {
-			diagnostics.AddErrorMsg(BuildExtractErr(x, table, column, err))
-		}
-		columnValues = append(columnValues, value)
-	}
-
-	value, err := md5_util.Md5String(strings.Join(columnValues, " | "))
+	value, err := row.PrimaryKeysID(table.GetPrimaryKeys())
 	if err != nil {
 		return nil, diagnostics.AddErrorMsg(BuildExtractErr(x, table, column, err))
 	}
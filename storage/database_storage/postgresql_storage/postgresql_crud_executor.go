@@ -3,6 +3,7 @@ package postgresql_storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
@@ -23,6 +24,7 @@ func (x *PostgresqlCRUDExecutor) SetClientMeta(clientMeta *schema.ClientMeta) {
 
 var _ storage.CRUDExecutor = &PostgresqlCRUDExecutor{}
 var _ storage.UseClientMeta = &PostgresqlCRUDExecutor{}
+var _ storage.ReadOnlyQueryExecutor = &PostgresqlCRUDExecutor{}
 
 func NewPostgresqlCRUDExecutor(pool *pgxpool.Pool) *PostgresqlCRUDExecutor {
 	return &PostgresqlCRUDExecutor{
@@ -53,6 +55,50 @@ func (x *PostgresqlCRUDExecutor) Query(ctx context.Context, query string, args .
 	}, nil
 }
 
+// ReadOnlyQuery Run the query in a READ ONLY transaction with SET LOCAL statement_timeout, so the database rejects any write even if
+// the query gets past the checks of the caller, and a slow query is cancelled by the server. The transaction is rolled back when
+// the query result is closed
+func (x *PostgresqlCRUDExecutor) ReadOnlyQuery(ctx context.Context, timeout time.Duration, query string, args ...any) (storage.QueryResult, *schema.Diagnostics) {
+
+	diagnostics := schema.NewDiagnostics()
+
+	tx, err := x.pool.BeginTx(ctx, pgx.TxOptions{
+		AccessMode: pgx.ReadOnly,
+	})
+	if err != nil {
+		return nil, diagnostics.AddErrorMsg("Postgresql read-only query begin transaction error: %s", err.Error())
+	}
+
+	if timeout > 0 {
+		// SET does not accept a parameter, the value is a number so it is safe to format it
+		_, err = tx.Exec(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", timeout.Milliseconds()))
+		if err != nil {
+			_ = tx.Rollback(context.Background())
+			return nil, diagnostics.AddErrorMsg("Postgresql read-only query set statement timeout error: %s", err.Error())
+		}
+	}
+
+	startTime := time.Now()
+	rows, err := tx.Query(ctx, query, args...)
+	cost := time.Now().Sub(startTime)
+
+	if err != nil {
+		_ = tx.Rollback(context.Background())
+		if x.clientMeta != nil {
+			x.clientMeta.Error("Postgresql sql read-only query error", zap.String("sql", query), zap.String("cost", cost.String()), zap.Error(err))
+		}
+		return nil, diagnostics.AddErrorMsg("Postgresql sql read-only query %s exec error: %s", query, err.Error())
+	}
+	if x.clientMeta != nil {
+		x.clientMeta.Debug("Postgresql sql read-only query success", zap.String("sql", query), zap.String("cost", cost.String()))
+	}
+
+	return &PostgresqlQueryResult{
+		rows: rows,
+		tx:   tx,
+	}, nil
+}
+
 func (x *PostgresqlCRUDExecutor) Exec(ctx context.Context, query string, args ...any) *schema.Diagnostics {
 	diagnostics := schema.NewDiagnostics()
 
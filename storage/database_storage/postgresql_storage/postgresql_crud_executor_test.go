@@ -5,6 +5,7 @@ import (
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestPostgresqlCRUDExecutor_Query(t *testing.T) {
@@ -102,3 +103,31 @@ func TestPostgresqlStorage_GetTime(t *testing.T) {
 	assert.Nil(t, err)
 	assert.False(t, time.IsZero())
 }
+
+func TestPostgresqlCRUDExecutor_ReadOnlyQuery(t *testing.T) {
+	diagnostics := schema.NewDiagnostics()
+
+	queryResult, d := testCrudExecutor.ReadOnlyQuery(context.Background(), time.Second*10, "SELECT 1")
+	assert.False(t, diagnostics.AddDiagnostics(d).HasError())
+	rows, d := queryResult.ReadRows(-1)
+	assert.False(t, diagnostics.AddDiagnostics(d).HasError())
+	assert.Equal(t, 1, rows.RowCount())
+	assert.False(t, diagnostics.AddDiagnostics(queryResult.Close()).HasError())
+
+	// the error may be returned by the query or when the rows are read
+	queryError := func(timeout time.Duration, sql string) *schema.Diagnostics {
+		queryResult, d := testCrudExecutor.ReadOnlyQuery(context.Background(), timeout, sql)
+		if d.HasError() {
+			return d
+		}
+		defer queryResult.Close()
+		_, d = queryResult.ReadRows(-1)
+		return d
+	}
+
+	// the write is rejected by the database
+	assert.True(t, queryError(time.Second*10, "CREATE TABLE read_only_query_test (id int)").HasError())
+
+	// cancelled by the statement timeout of the server
+	assert.True(t, queryError(time.Millisecond*100, "SELECT pg_sleep(10)").HasError())
+}
@@ -1,6 +1,8 @@
 package postgresql_storage
 
 import (
+	"context"
+	"errors"
 	"github.com/jackc/pgx/v4"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
 	"github.com/selefra/selefra-provider-sdk/storage"
@@ -8,6 +10,9 @@ import (
 
 type PostgresqlQueryResult struct {
 	rows pgx.Rows
+
+	// The transaction the query is run in, it is rolled back when the result is closed, nil if the query is not run in a transaction
+	tx pgx.Tx
 }
 
 var _ storage.QueryResult = &PostgresqlQueryResult{}
@@ -16,6 +21,13 @@ func (x *PostgresqlQueryResult) Next() bool {
 	return x.rows.Next()
 }
 
+func (x *PostgresqlQueryResult) Err() *schema.Diagnostics {
+	if err := x.rows.Err(); err != nil {
+		return schema.NewDiagnostics().AddErrorMsg("PostgresqlQueryResult read rows error: %s", err.Error())
+	}
+	return nil
+}
+
 func (x *PostgresqlQueryResult) Decode(item any) *schema.Diagnostics {
 	diagnostics := schema.NewDiagnostics()
 	err := x.rows.Scan(item)
@@ -64,6 +76,9 @@ func (x *PostgresqlQueryResult) ReadRows(rowLimit int) (*schema.Rows, *schema.Di
 			return nil, diagnostics.AddErrorMsg("PostgresqlQueryResult read rows error: %s", err.Error())
 		}
 	}
+	if diagnostics.AddDiagnostics(x.Err()).HasError() {
+		return rows, diagnostics
+	}
 	return rows, nil
 }
 
@@ -77,6 +92,12 @@ func (x *PostgresqlQueryResult) GetColumnNames() []string {
 
 func (x *PostgresqlQueryResult) Close() *schema.Diagnostics {
 	x.rows.Close()
+	if x.tx != nil {
+		// the context of the query may be done already, the transaction still has to be ended
+		if err := x.tx.Rollback(context.Background()); err != nil && !errors.Is(err, pgx.ErrTxClosed) {
+			return schema.NewDiagnostics().AddErrorMsg("PostgresqlQueryResult rollback transaction error: %s", err.Error())
+		}
+	}
 	return nil
 }
 
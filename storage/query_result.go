@@ -10,6 +10,10 @@ type QueryResult interface {
 	// Next Attempts to switch to the next result and returns whether the switch was successful
 	Next() bool
 
+	// Err The error that stopped Next before all the rows are read, for example the connection is lost, check it after Next return false,
+	// nil means all the rows are read
+	Err() *schema.Diagnostics
+
 	// Decode the current ROW as an item, which should be the address of a struct
 	Decode(item any) *schema.Diagnostics
 
@@ -89,6 +89,12 @@ type CRUDExecutor interface {
 	Insert(ctx context.Context, t *schema.Table, rowSet *schema.Rows) *schema.Diagnostics
 }
 
+// ReadOnlyQueryExecutor The storage can run a query that is not allowed to modify anything, the query is run in a read-only transaction
+// with a statement timeout on the server side, and the transaction is always rolled back when the query result is closed
+type ReadOnlyQueryExecutor interface {
+	ReadOnlyQuery(ctx context.Context, timeout time.Duration, query string, args ...any) (QueryResult, *schema.Diagnostics)
+}
+
 type KeyValueExecutor interface {
 	SetKey(ctx context.Context, key, value string) *schema.Diagnostics
 
@@ -2,6 +2,7 @@ package storage_factory
 
 import (
 	"context"
+	"sort"
 	"github.com/selefra/selefra-provider-sdk/provider/schema"
 	"github.com/selefra/selefra-provider-sdk/storage"
 	"github.com/selefra/selefra-provider-sdk/storage/database_storage/postgresql_storage"
@@ -93,3 +94,15 @@ func NewStorage(ctx context.Context, storageType StorageType, options storage.Cr
 	}
 	return function(ctx, options)
 }
+
+// GetRegisteredStorageTypes The storage types that can be created, in the order of the type
+func GetRegisteredStorageTypes() []StorageType {
+	storageTypes := make([]StorageType, 0, len(storageFactoryMethodMap))
+	for storageType := range storageFactoryMethodMap {
+		storageTypes = append(storageTypes, storageType)
+	}
+	sort.Slice(storageTypes, func(i, j int) bool {
+		return storageTypes[i] < storageTypes[j]
+	})
+	return storageTypes
+}
@@ -0,0 +1,295 @@
+package table_exporter
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-provider-sdk/storage"
+)
+
+// ExportFormat The file format the table data is exported to
+type ExportFormat string
+
+const (
+	ExportFormatCSV       ExportFormat = "csv"
+	ExportFormatJSONLines ExportFormat = "jsonl"
+	ExportFormatParquet   ExportFormat = "parquet"
+)
+
+// DefaultExportChunkSize How many rows are written before flush to file when the chunk size is not specified
+const DefaultExportChunkSize = 1000
+
+// ManifestFileName The manifest is written to the output directory with this name
+const ManifestFileName = "manifest.json"
+
+// ExportOptions Some options of the exporter
+type ExportOptions struct {
+
+	// Which namespace of the storage the tables are in, like database schema
+	Namespace string
+
+	// The directory to which the exported files are written, one file per table plus a manifest
+	OutputDirectory string
+
+	// Which format to export
+	Format ExportFormat
+
+	// The rows are read from storage row by row, and flushed to file every ChunkSize rows,
+	// so a big table is never loaded into memory at once
+	ChunkSize int
+
+	// The storage only knows the table names and column names, if you give the declared tables here (for example provider.TableList),
+	// the column types will be mapped from schema.ColumnType, otherwise the columns are exported as string
+	Tables []*schema.Table
+}
+
+// TableExporter Export the tables in storage to files, so that the pulled data can be shipped to somewhere else, like a data lake
+type TableExporter struct {
+	storage storage.Storage
+	options *ExportOptions
+
+	// <tableName, declared table>, include sub tables
+	declaredTableMap map[string]*schema.Table
+}
+
+func NewTableExporter(storage storage.Storage, options *ExportOptions) *TableExporter {
+	if options.ChunkSize <= 0 {
+		options.ChunkSize = DefaultExportChunkSize
+	}
+	exporter := &TableExporter{
+		storage:          storage,
+		options:          options,
+		declaredTableMap: make(map[string]*schema.Table),
+	}
+	for _, table := range options.Tables {
+		exporter.flatDeclaredTable(table)
+	}
+	return exporter
+}
+
+func (x *TableExporter) flatDeclaredTable(table *schema.Table) {
+	if table == nil {
+		return
+	}
+	x.declaredTableMap[table.TableName] = table
+	for _, subTable := range table.SubTables {
+		x.flatDeclaredTable(subTable)
+	}
+}
+
+// ExportManifest Describe which files have been exported, it is written to the output directory along with the data files
+type ExportManifest struct {
+	Format     ExportFormat     `json:"format"`
+	Namespace  string           `json:"namespace"`
+	ExportedAt time.Time        `json:"exported_at"`
+	Tables     []*ExportedTable `json:"tables"`
+}
+
+// ExportedTable The export result of one table
+type ExportedTable struct {
+	TableName string            `json:"table_name"`
+	FileName  string            `json:"file_name"`
+	RowCount  uint64            `json:"row_count"`
+	Columns   []*ExportedColumn `json:"columns"`
+}
+
+type ExportedColumn struct {
+	ColumnName string `json:"column_name"`
+	ColumnType string `json:"column_type"`
+}
+
+// ExportAllTables Export all the tables under the namespace
+func (x *TableExporter) ExportAllTables(ctx context.Context) (*ExportManifest, *schema.Diagnostics) {
+	return x.ExportTables(ctx)
+}
+
+// ExportTables Export the given tables, if no table name is given, export all tables under the namespace
+func (x *TableExporter) ExportTables(ctx context.Context, tableNames ...string) (*ExportManifest, *schema.Diagnostics) {
+
+	diagnostics := schema.NewDiagnostics()
+
+	if !x.isSupportFormat(x.options.Format) {
+		return nil, diagnostics.AddErrorMsg("table exporter does not support format %s", x.options.Format)
+	}
+
+	storageTables, d := x.storage.TableList(ctx, x.options.Namespace)
+	if diagnostics.AddDiagnostics(d).HasError() {
+		return nil, diagnostics
+	}
+	storageTableMap := make(map[string]*schema.Table, len(storageTables))
+	for _, table := range storageTables {
+		storageTableMap[table.TableName] = table
+	}
+
+	if len(tableNames) == 0 {
+		for tableName := range storageTableMap {
+			tableNames = append(tableNames, tableName)
+		}
+		sort.Strings(tableNames)
+	}
+
+	if err := os.MkdirAll(x.options.OutputDirectory, os.ModePerm); err != nil {
+		return nil, diagnostics.AddErrorMsg("table exporter create output directory %s error: %s", x.options.OutputDirectory, err.Error())
+	}
+
+	manifest := &ExportManifest{
+		Format:     x.options.Format,
+		Namespace:  x.options.Namespace,
+		ExportedAt: time.Now(),
+		Tables:     make([]*ExportedTable, 0),
+	}
+	for _, tableName := range tableNames {
+		storageTable, exists := storageTableMap[tableName]
+		if !exists {
+			diagnostics.AddErrorMsg("table exporter export table %s error: table not exists in storage", tableName)
+			continue
+		}
+		exportedTable, d := x.exportTable(ctx, storageTable)
+		if diagnostics.AddDiagnostics(d).HasError() {
+			continue
+		}
+		manifest.Tables = append(manifest.Tables, exportedTable)
+	}
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return manifest, diagnostics.AddErrorMsg("table exporter marshal manifest error: %s", err.Error())
+	}
+	if err := os.WriteFile(filepath.Join(x.options.OutputDirectory, ManifestFileName), manifestBytes, 0644); err != nil {
+		return manifest, diagnostics.AddErrorMsg("table exporter write manifest error: %s", err.Error())
+	}
+
+	return manifest, diagnostics
+}
+
+// ExportTable Export only one table, the manifest will only contains this table
+func (x *TableExporter) ExportTable(ctx context.Context, tableName string) (*ExportedTable, *schema.Diagnostics) {
+	manifest, diagnostics := x.ExportTables(ctx, tableName)
+	if manifest == nil || len(manifest.Tables) == 0 {
+		return nil, diagnostics
+	}
+	return manifest.Tables[0], diagnostics
+}
+
+func (x *TableExporter) exportTable(ctx context.Context, storageTable *schema.Table) (*ExportedTable, *schema.Diagnostics) {
+
+	diagnostics := schema.NewDiagnostics()
+
+	columns := x.resolveColumns(storageTable)
+	if len(columns) == 0 {
+		return nil, diagnostics.AddErrorMsg("table exporter export table %s error: table have no column", storageTable.TableName)
+	}
+
+	fileName := storageTable.TableName + "." + string(x.options.Format)
+	file, err := os.Create(filepath.Join(x.options.OutputDirectory, fileName))
+	if err != nil {
+		return nil, diagnostics.AddErrorMsg("table exporter create file %s error: %s", fileName, err.Error())
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	writer, err := newTableWriter(x.options.Format, file, columns)
+	if err != nil {
+		return nil, diagnostics.AddErrorMsg("table exporter create %s writer for table %s error: %s", x.options.Format, storageTable.TableName, err.Error())
+	}
+
+	queryResult, d := x.storage.Query(ctx, x.buildSelectSql(storageTable, columns))
+	if diagnostics.AddDiagnostics(d).HasError() {
+		return nil, diagnostics
+	}
+	defer func() {
+		queryResult.Close()
+	}()
+
+	// Streaming, so that a large table is not loaded into memory as schema.Rows
+	rowCount := uint64(0)
+	for queryResult.Next() {
+		values, d := queryResult.Values()
+		if diagnostics.AddDiagnostics(d).HasError() {
+			return nil, diagnostics
+		}
+		if err := writer.Write(values); err != nil {
+			return nil, diagnostics.AddErrorMsg("table exporter write table %s row error: %s", storageTable.TableName, err.Error())
+		}
+		rowCount++
+		if rowCount%uint64(x.options.ChunkSize) == 0 {
+			if err := writer.Flush(); err != nil {
+				return nil, diagnostics.AddErrorMsg("table exporter flush table %s error: %s", storageTable.TableName, err.Error())
+			}
+		}
+	}
+	// Next also returns false when the stream breaks, the file would be truncated
+	if diagnostics.AddDiagnostics(queryResult.Err()).HasError() {
+		return nil, diagnostics.AddErrorMsg("table exporter read table %s stopped after %d rows", storageTable.TableName, rowCount)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, diagnostics.AddErrorMsg("table exporter close table %s writer error: %s", storageTable.TableName, err.Error())
+	}
+
+	exportedTable := &ExportedTable{
+		TableName: storageTable.TableName,
+		FileName:  fileName,
+		RowCount:  rowCount,
+		Columns:   make([]*ExportedColumn, 0, len(columns)),
+	}
+	for _, column := range columns {
+		exportedTable.Columns = append(exportedTable.Columns, &ExportedColumn{
+			ColumnName: column.ColumnName,
+			ColumnType: column.Type.String(),
+		})
+	}
+	return exportedTable, diagnostics
+}
+
+// Column types come from the declared table, if the table or the column is not declared, it is exported as string
+func (x *TableExporter) resolveColumns(storageTable *schema.Table) []*schema.Column {
+	declaredColumnMap := make(map[string]*schema.Column)
+	if declaredTable, exists := x.declaredTableMap[storageTable.TableName]; exists {
+		for _, column := range declaredTable.Columns {
+			declaredColumnMap[column.ColumnName] = column
+		}
+	}
+	columns := make([]*schema.Column, 0, len(storageTable.Columns))
+	for _, storageColumn := range storageTable.Columns {
+		column := &schema.Column{
+			ColumnName: storageColumn.ColumnName,
+			Type:       schema.ColumnTypeString,
+		}
+		if declaredColumn, exists := declaredColumnMap[storageColumn.ColumnName]; exists && declaredColumn.Type != schema.ColumnTypeNotAssign {
+			column.Type = declaredColumn.Type
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// The identifiers are quoted as SQL identifiers, the " in them is doubled
+func (x *TableExporter) buildSelectSql(table *schema.Table, columns []*schema.Column) string {
+	columnNameSlice := make([]string, 0, len(columns))
+	for _, column := range columns {
+		columnNameSlice = append(columnNameSlice, pgx.Identifier{column.ColumnName}.Sanitize())
+	}
+	tableName := pgx.Identifier{table.TableName}
+	if x.options.Namespace != "" {
+		tableName = pgx.Identifier{x.options.Namespace, table.TableName}
+	}
+	return "SELECT " + strings.Join(columnNameSlice, ", ") + " FROM " + tableName.Sanitize()
+}
+
+func (x *TableExporter) isSupportFormat(format ExportFormat) bool {
+	switch format {
+	case ExportFormatCSV, ExportFormatJSONLines, ExportFormatParquet:
+		return true
+	default:
+		return false
+	}
+}
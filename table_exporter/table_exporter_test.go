@@ -0,0 +1,220 @@
+package table_exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/jackc/pgtype"
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-provider-sdk/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+// A storage only can list tables and query, enough for exporter
+type fakeStorage struct {
+	storage.Storage
+	tables     []*schema.Table
+	rows       *schema.Rows
+	breakAfter int
+	queries    []string
+}
+
+func (x *fakeStorage) TableList(ctx context.Context, namespace string) ([]*schema.Table, *schema.Diagnostics) {
+	return x.tables, nil
+}
+
+func (x *fakeStorage) Query(ctx context.Context, query string, args ...any) (storage.QueryResult, *schema.Diagnostics) {
+	x.queries = append(x.queries, query)
+	return &fakeQueryResult{rows: x.rows, index: -1, breakAfter: x.breakAfter}, nil
+}
+
+type fakeQueryResult struct {
+	storage.QueryResult
+	rows  *schema.Rows
+	index int
+
+	// The stream breaks after so many rows, 0 means never
+	breakAfter int
+}
+
+func (x *fakeQueryResult) Next() bool {
+	x.index++
+	if x.breakAfter > 0 && x.index >= x.breakAfter {
+		return false
+	}
+	return x.index < x.rows.RowCount()
+}
+
+func (x *fakeQueryResult) Err() *schema.Diagnostics {
+	if x.breakAfter > 0 && x.index >= x.breakAfter {
+		return schema.NewDiagnostics().AddErrorMsg("unexpected EOF")
+	}
+	return nil
+}
+
+func (x *fakeQueryResult) Values() ([]any, *schema.Diagnostics) {
+	values, err := x.rows.GetRowValues(x.index)
+	if err != nil {
+		return nil, schema.NewDiagnostics().AddErrorMsg(err.Error())
+	}
+	return values, nil
+}
+
+func (x *fakeQueryResult) Close() *schema.Diagnostics {
+	return nil
+}
+
+// Decode the text of the value like pgx rows.Values does, so the exporter gets the types the driver returns, such as pgtype.TextArray
+func driverValue(t *testing.T, typeName, text string) any {
+	connInfo := pgtype.NewConnInfo()
+	dataType, ok := connInfo.DataTypeForName(typeName)
+	assert.True(t, ok, typeName)
+	value := pgtype.NewValue(dataType.Value)
+	assert.Nil(t, value.(pgtype.TextDecoder).DecodeText(connInfo, []byte(text)))
+	return value.Get()
+}
+
+func newTestExporter(t *testing.T, format ExportFormat) (*TableExporter, string) {
+	exporter, outputDirectory, _ := newTestExporterWithStorage(t, format)
+	return exporter, outputDirectory
+}
+
+func newTestExporterWithStorage(t *testing.T, format ExportFormat) (*TableExporter, string, *fakeStorage) {
+	declaredTable := &schema.Table{
+		TableName: "test_export_table",
+		Columns: []*schema.Column{
+			{ColumnName: "name", Type: schema.ColumnTypeString},
+			{ColumnName: "age", Type: schema.ColumnTypeInt},
+			{ColumnName: "tags", Type: schema.ColumnTypeStringArray},
+			{ColumnName: "meta", Type: schema.ColumnTypeJSON},
+			{ColumnName: "ip", Type: schema.ColumnTypeIp},
+			{ColumnName: "created_at", Type: schema.ColumnTypeTimestamp},
+			{ColumnName: "ports", Type: schema.ColumnTypeIntArray},
+		},
+	}
+	storageTable := &schema.Table{TableName: "test_export_table"}
+	for _, column := range declaredTable.Columns {
+		storageTable.Columns = append(storageTable.Columns, &schema.Column{ColumnName: column.ColumnName})
+	}
+
+	rows := schema.NewRows("name", "age", "tags", "meta", "ip", "created_at", "ports")
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, rows.AppendRowValues([]any{
+			driverValue(t, "text", "foo"),
+			driverValue(t, "int4", strconv.Itoa(i)),
+			driverValue(t, "_text", "{a,b}"),
+			driverValue(t, "jsonb", `{"k": "v"}`),
+			driverValue(t, "inet", "10.0.0.1/32"),
+			driverValue(t, "timestamp", "2022-12-01 08:00:00"),
+			driverValue(t, "_int4", "{80,443}"),
+		}))
+	}
+	// pgx returns nil for the NULL values
+	assert.Nil(t, rows.AppendRowValues([]any{nil, nil, nil, nil, nil, nil, nil}))
+
+	outputDirectory := t.TempDir()
+	fakeStorage := &fakeStorage{tables: []*schema.Table{storageTable}, rows: rows}
+	exporter := NewTableExporter(fakeStorage, &ExportOptions{
+		OutputDirectory: outputDirectory,
+		Format:          format,
+		ChunkSize:       2,
+		Tables:          []*schema.Table{declaredTable},
+	})
+	return exporter, outputDirectory, fakeStorage
+}
+
+func TestTableExporter_ExportCSV(t *testing.T) {
+	exporter, outputDirectory := newTestExporter(t, ExportFormatCSV)
+	manifest, d := exporter.ExportAllTables(context.Background())
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, 1, len(manifest.Tables))
+	assert.Equal(t, uint64(4), manifest.Tables[0].RowCount)
+	assert.Equal(t, "string_array", manifest.Tables[0].Columns[2].ColumnType)
+
+	file, err := os.Open(filepath.Join(outputDirectory, "test_export_table.csv"))
+	assert.Nil(t, err)
+	defer file.Close()
+	records, err := csv.NewReader(file).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(records))
+	assert.Equal(t, []string{"name", "age", "tags", "meta", "ip", "created_at", "ports"}, records[0])
+	assert.Equal(t, []string{"foo", "1", `["a","b"]`, `{"k":"v"}`, "10.0.0.1", "2022-12-01T08:00:00Z", "[80,443]"}, records[2])
+	assert.Equal(t, []string{"", "", "", "", "", "", ""}, records[4])
+
+	_, err = os.Stat(filepath.Join(outputDirectory, ManifestFileName))
+	assert.Nil(t, err)
+}
+
+func TestTableExporter_ExportJSONLines(t *testing.T) {
+	exporter, outputDirectory := newTestExporter(t, ExportFormatJSONLines)
+	exportedTable, d := exporter.ExportTable(context.Background(), "test_export_table")
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, uint64(4), exportedTable.RowCount)
+
+	file, err := os.Open(filepath.Join(outputDirectory, "test_export_table.jsonl"))
+	assert.Nil(t, err)
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	lines := make([]map[string]any, 0)
+	for scanner.Scan() {
+		line := make(map[string]any)
+		assert.Nil(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	assert.Equal(t, 4, len(lines))
+	assert.Equal(t, []any{"a", "b"}, lines[0]["tags"])
+	assert.Equal(t, map[string]any{"k": "v"}, lines[0]["meta"])
+	assert.Equal(t, "10.0.0.1", lines[0]["ip"])
+	assert.Equal(t, []any{float64(80), float64(443)}, lines[0]["ports"])
+	assert.Nil(t, lines[3]["name"])
+}
+
+func TestTableExporter_ExportParquet(t *testing.T) {
+	exporter, outputDirectory := newTestExporter(t, ExportFormatParquet)
+	manifest, d := exporter.ExportAllTables(context.Background())
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, uint64(4), manifest.Tables[0].RowCount)
+
+	fileReader, err := local.NewLocalFileReader(filepath.Join(outputDirectory, "test_export_table.parquet"))
+	assert.Nil(t, err)
+	defer fileReader.Close()
+	parquetReader, err := reader.NewParquetReader(fileReader, nil, 1)
+	assert.Nil(t, err)
+	defer parquetReader.ReadStop()
+	assert.Equal(t, int64(4), parquetReader.GetNumRows())
+}
+
+func TestTableExporter_ExportTableNotExists(t *testing.T) {
+	exporter, _ := newTestExporter(t, ExportFormatCSV)
+	exportedTable, d := exporter.ExportTable(context.Background(), "not_exists_table")
+	assert.Nil(t, exportedTable)
+	assert.True(t, d.HasError())
+}
+
+func TestTableExporter_ExportStreamBroken(t *testing.T) {
+	exporter, _, fakeStorage := newTestExporterWithStorage(t, ExportFormatCSV)
+	fakeStorage.breakAfter = 2
+	exportedTable, d := exporter.ExportTable(context.Background(), "test_export_table")
+	assert.Nil(t, exportedTable)
+	assert.True(t, d.HasError())
+}
+
+func TestTableExporter_BuildSelectSql(t *testing.T) {
+	exporter, _, fakeStorage := newTestExporterWithStorage(t, ExportFormatCSV)
+	exporter.options.Namespace = "my schema"
+	table := &schema.Table{TableName: `weird"table`}
+	columns := []*schema.Column{{ColumnName: `a"b`}, {ColumnName: `c\d`}}
+	assert.Equal(t, `SELECT "a""b", "c\d" FROM "my schema"."weird""table"`, exporter.buildSelectSql(table, columns))
+
+	_, d := exporter.ExportAllTables(context.Background())
+	assert.False(t, d.HasError(), d.ToString())
+	assert.Equal(t, `SELECT "name", "age", "tags", "meta", "ip", "created_at", "ports" FROM "my schema"."test_export_table"`, fakeStorage.queries[len(fakeStorage.queries)-1])
+}
@@ -0,0 +1,193 @@
+package table_exporter
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"time"
+
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/selefra/selefra-utils/pkg/reflect_util"
+)
+
+// The value read from storage may be a driver specific type, here it is normalized to go basic types according to schema.ColumnType,
+// after normalized, it only contains nil, bool, number, string, time.Time, []byte, slice and json value
+
+// Some driver type can assign itself to go types, for example pgtype arrays
+type assignable interface {
+	AssignTo(dst any) error
+}
+
+// pgx returns the pgtype arrays by value, but AssignTo is on the pointer, so take the pointer of a copy
+func asAssignable(value any) (assignable, bool) {
+	if v, ok := value.(assignable); ok {
+		return v, true
+	}
+	pointer := reflect.New(reflect.TypeOf(value))
+	pointer.Elem().Set(reflect.ValueOf(value))
+	v, ok := pointer.Interface().(assignable)
+	return v, ok
+}
+
+func normalizeValue(columnType schema.ColumnType, value any) any {
+
+	if reflect_util.IsNil(value) {
+		return nil
+	}
+
+	switch columnType {
+	case schema.ColumnTypeIntArray:
+		if v, ok := asAssignable(value); ok {
+			var intSlice []int64
+			if err := v.AssignTo(&intSlice); err == nil {
+				return intSlice
+			}
+		}
+		return normalizeSlice(columnType, value)
+	case schema.ColumnTypeStringArray:
+		if v, ok := asAssignable(value); ok {
+			var stringSlice []string
+			if err := v.AssignTo(&stringSlice); err == nil {
+				return stringSlice
+			}
+		}
+		return normalizeSlice(columnType, value)
+	case schema.ColumnTypeIpArray, schema.ColumnTypeCIDRArray:
+		if v, ok := asAssignable(value); ok {
+			var ipNetSlice []*net.IPNet
+			if err := v.AssignTo(&ipNetSlice); err == nil {
+				return normalizeSlice(columnType, ipNetSlice)
+			}
+		}
+		return normalizeSlice(columnType, value)
+	case schema.ColumnTypeMacAddrArray:
+		if v, ok := asAssignable(value); ok {
+			var macSlice []net.HardwareAddr
+			if err := v.AssignTo(&macSlice); err == nil {
+				return normalizeSlice(columnType, macSlice)
+			}
+		}
+		return normalizeSlice(columnType, value)
+	case schema.ColumnTypeJSON:
+		// json column keep the decoded value as is, but if it is raw json text, decode it
+		switch v := value.(type) {
+		case []byte:
+			var jsonValue any
+			if err := json.Unmarshal(v, &jsonValue); err == nil {
+				return jsonValue
+			}
+			return string(v)
+		case json.RawMessage:
+			var jsonValue any
+			if err := json.Unmarshal(v, &jsonValue); err == nil {
+				return jsonValue
+			}
+			return string(v)
+		default:
+			return value
+		}
+	default:
+		return normalizeScalar(columnType, value)
+	}
+}
+
+func normalizeSlice(columnType schema.ColumnType, value any) any {
+	reflectValue := reflect.ValueOf(value)
+	if reflectValue.Kind() != reflect.Slice && reflectValue.Kind() != reflect.Array {
+		return normalizeScalar(columnType, value)
+	}
+	elementType := arrayElementColumnType(columnType)
+	result := make([]any, 0, reflectValue.Len())
+	for index := 0; index < reflectValue.Len(); index++ {
+		result = append(result, normalizeScalar(elementType, reflectValue.Index(index).Interface()))
+	}
+	return result
+}
+
+func normalizeScalar(columnType schema.ColumnType, value any) any {
+
+	if reflect_util.IsNil(value) {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case net.IPNet:
+		return formatIPNet(columnType, &v)
+	case *net.IPNet:
+		return formatIPNet(columnType, v)
+	case net.IP:
+		return v.String()
+	case net.HardwareAddr:
+		return v.String()
+	case time.Time, []byte, string, bool,
+		int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return v
+	}
+}
+
+// An ip column is stored as inet, if it is a single host, only the address is exported
+func formatIPNet(columnType schema.ColumnType, ipNet *net.IPNet) string {
+	if columnType == schema.ColumnTypeIp || columnType == schema.ColumnTypeIpArray {
+		if ones, bits := ipNet.Mask.Size(); ones == bits {
+			return ipNet.IP.String()
+		}
+	}
+	return ipNet.String()
+}
+
+func arrayElementColumnType(columnType schema.ColumnType) schema.ColumnType {
+	switch columnType {
+	case schema.ColumnTypeIntArray:
+		return schema.ColumnTypeBigInt
+	case schema.ColumnTypeStringArray:
+		return schema.ColumnTypeString
+	case schema.ColumnTypeIpArray:
+		return schema.ColumnTypeIp
+	case schema.ColumnTypeCIDRArray:
+		return schema.ColumnTypeCIDR
+	case schema.ColumnTypeMacAddrArray:
+		return schema.ColumnTypeMacAddr
+	default:
+		return columnType
+	}
+}
+
+func isArrayColumnType(columnType schema.ColumnType) bool {
+	switch columnType {
+	case schema.ColumnTypeIntArray, schema.ColumnTypeStringArray, schema.ColumnTypeIpArray, schema.ColumnTypeCIDRArray, schema.ColumnTypeMacAddrArray:
+		return true
+	default:
+		return false
+	}
+}
+
+// ------------------------------------------------- ------------------------------------------------------------------------
+
+// Format the normalized value as a text cell, used by csv
+func formatTextCell(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case time.Time:
+		return v.Format(time.RFC3339Nano), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v), nil
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		return fmt.Sprintf("%v", v), nil
+	default:
+		// array and json is exported as json text
+		marshal, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return string(marshal), nil
+	}
+}
@@ -0,0 +1,254 @@
+package table_exporter
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/selefra/selefra-provider-sdk/provider/schema"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// tableWriter Write the rows of one table to a file
+type tableWriter interface {
+
+	// Write a row, the values are in the same order as the columns
+	Write(values []any) error
+
+	// Flush Write the buffered rows to the underlying file
+	Flush() error
+
+	// Close Flush and write the file footer if any, do not close the underlying file
+	Close() error
+}
+
+func newTableWriter(format ExportFormat, w io.Writer, columns []*schema.Column) (tableWriter, error) {
+	switch format {
+	case ExportFormatCSV:
+		return newCsvTableWriter(w, columns)
+	case ExportFormatJSONLines:
+		return newJsonLinesTableWriter(w, columns), nil
+	case ExportFormatParquet:
+		return newParquetTableWriter(w, columns)
+	default:
+		return nil, fmt.Errorf("not support format %s", format)
+	}
+}
+
+// ------------------------------------------------- csv ---------------------------------------------------------------
+
+type csvTableWriter struct {
+	writer  *csv.Writer
+	columns []*schema.Column
+}
+
+func newCsvTableWriter(w io.Writer, columns []*schema.Column) (*csvTableWriter, error) {
+	csvWriter := csv.NewWriter(w)
+	header := make([]string, 0, len(columns))
+	for _, column := range columns {
+		header = append(header, column.ColumnName)
+	}
+	if err := csvWriter.Write(header); err != nil {
+		return nil, err
+	}
+	return &csvTableWriter{
+		writer:  csvWriter,
+		columns: columns,
+	}, nil
+}
+
+func (x *csvTableWriter) Write(values []any) error {
+	if len(values) != len(x.columns) {
+		return errors.New("wrong number of columns")
+	}
+	record := make([]string, 0, len(values))
+	for index, value := range values {
+		cell, err := formatTextCell(normalizeValue(x.columns[index].Type, value))
+		if err != nil {
+			return fmt.Errorf("column %s format error: %s", x.columns[index].ColumnName, err.Error())
+		}
+		record = append(record, cell)
+	}
+	return x.writer.Write(record)
+}
+
+func (x *csvTableWriter) Flush() error {
+	x.writer.Flush()
+	return x.writer.Error()
+}
+
+func (x *csvTableWriter) Close() error {
+	return x.Flush()
+}
+
+// ------------------------------------------------- json lines --------------------------------------------------------
+
+type jsonLinesTableWriter struct {
+	writer  *bufio.Writer
+	encoder *json.Encoder
+	columns []*schema.Column
+}
+
+func newJsonLinesTableWriter(w io.Writer, columns []*schema.Column) *jsonLinesTableWriter {
+	bufferWriter := bufio.NewWriter(w)
+	return &jsonLinesTableWriter{
+		writer:  bufferWriter,
+		encoder: json.NewEncoder(bufferWriter),
+		columns: columns,
+	}
+}
+
+func (x *jsonLinesTableWriter) Write(values []any) error {
+	if len(values) != len(x.columns) {
+		return errors.New("wrong number of columns")
+	}
+	line := make(map[string]any, len(values))
+	for index, value := range values {
+		line[x.columns[index].ColumnName] = normalizeValue(x.columns[index].Type, value)
+	}
+	// Encode append a newline after each value, so it is a json line
+	return x.encoder.Encode(line)
+}
+
+func (x *jsonLinesTableWriter) Flush() error {
+	return x.writer.Flush()
+}
+
+func (x *jsonLinesTableWriter) Close() error {
+	return x.Flush()
+}
+
+// ------------------------------------------------- parquet -----------------------------------------------------------
+
+type parquetTableWriter struct {
+	writer  *writer.JSONWriter
+	columns []*schema.Column
+}
+
+func newParquetTableWriter(w io.Writer, columns []*schema.Column) (*parquetTableWriter, error) {
+	jsonSchema, err := buildParquetJsonSchema(columns)
+	if err != nil {
+		return nil, err
+	}
+	jsonWriter, err := writer.NewJSONWriterFromWriter(jsonSchema, w, 1)
+	if err != nil {
+		return nil, err
+	}
+	return &parquetTableWriter{
+		writer:  jsonWriter,
+		columns: columns,
+	}, nil
+}
+
+func (x *parquetTableWriter) Write(values []any) error {
+	if len(values) != len(x.columns) {
+		return errors.New("wrong number of columns")
+	}
+	record := make(map[string]any, len(values))
+	for index, value := range values {
+		column := x.columns[index]
+		parquetValue, err := toParquetValue(column.Type, normalizeValue(column.Type, value))
+		if err != nil {
+			return fmt.Errorf("column %s format error: %s", column.ColumnName, err.Error())
+		}
+		// absent key is written as null
+		if parquetValue != nil {
+			record[column.ColumnName] = parquetValue
+		}
+	}
+	recordBytes, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return x.writer.Write(string(recordBytes))
+}
+
+func (x *parquetTableWriter) Flush() error {
+	return x.writer.Flush(true)
+}
+
+func (x *parquetTableWriter) Close() error {
+	return x.writer.WriteStop()
+}
+
+type parquetSchemaField struct {
+	Tag    string                `json:"Tag"`
+	Fields []*parquetSchemaField `json:"Fields,omitempty"`
+}
+
+// Build the parquet schema from column types, all the columns are optional, because the column value may be null
+func buildParquetJsonSchema(columns []*schema.Column) (string, error) {
+	root := &parquetSchemaField{
+		Tag:    "name=selefra_table_root",
+		Fields: make([]*parquetSchemaField, 0, len(columns)),
+	}
+	for _, column := range columns {
+		if isArrayColumnType(column.Type) {
+			root.Fields = append(root.Fields, &parquetSchemaField{
+				Tag: "name=" + column.ColumnName + ", type=LIST, repetitiontype=OPTIONAL",
+				Fields: []*parquetSchemaField{
+					{
+						Tag: "name=element, " + parquetPrimitiveTag(arrayElementColumnType(column.Type)) + ", repetitiontype=OPTIONAL",
+					},
+				},
+			})
+		} else {
+			root.Fields = append(root.Fields, &parquetSchemaField{
+				Tag: "name=" + column.ColumnName + ", " + parquetPrimitiveTag(column.Type) + ", repetitiontype=OPTIONAL",
+			})
+		}
+	}
+	marshal, err := json.Marshal(root)
+	if err != nil {
+		return "", err
+	}
+	return string(marshal), nil
+}
+
+func parquetPrimitiveTag(columnType schema.ColumnType) string {
+	switch columnType {
+	case schema.ColumnTypeSmallInt, schema.ColumnTypeInt:
+		return "type=INT32"
+	case schema.ColumnTypeBigInt:
+		return "type=INT64"
+	case schema.ColumnTypeFloat:
+		return "type=DOUBLE"
+	case schema.ColumnTypeBool:
+		return "type=BOOLEAN"
+	case schema.ColumnTypeTimestamp:
+		return "type=INT64, convertedtype=TIMESTAMP_MILLIS"
+	default:
+		// string, json text, byte array(base64), ip, cidr, mac address
+		return "type=BYTE_ARRAY, convertedtype=UTF8"
+	}
+}
+
+func toParquetValue(columnType schema.ColumnType, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if isArrayColumnType(columnType) {
+		return value, nil
+	}
+	switch columnType {
+	case schema.ColumnTypeTimestamp:
+		if t, ok := value.(time.Time); ok {
+			return t.UnixMilli(), nil
+		}
+		return nil, fmt.Errorf("timestamp column value type %T not supported", value)
+	case schema.ColumnTypeJSON:
+		marshal, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		return string(marshal), nil
+	case schema.ColumnTypeSmallInt, schema.ColumnTypeInt, schema.ColumnTypeBigInt, schema.ColumnTypeFloat, schema.ColumnTypeBool:
+		return value, nil
+	default:
+		return formatTextCell(value)
+	}
+}